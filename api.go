@@ -0,0 +1,104 @@
+package portone
+
+import "context"
+
+// AuthAPI is the API of 'POST /users/getToken'.
+type AuthAPI interface {
+	GetToken(ctx context.Context, req GetTokenRequest) (GetTokenResponse, error)
+}
+
+// PaymentsAPI is the API of the '/payments' endpoints.
+type PaymentsAPI interface {
+	CreatePaymentIntent(ctx context.Context, req CreatePaymentIntentRequest) (CreatePaymentIntentResponse, error)
+	GetPayment(ctx context.Context, paymentID string) (GetPaymentResponse, error)
+	FindPayment(ctx context.Context, merchantUID string) (FindPaymentResponse, error)
+	GetPaymentsByStatus(ctx context.Context, req GetPaymentsByStatusRequest) (GetPaymentsByStatusResponse, error)
+	CancelPayment(ctx context.Context, req CancelPaymentRequest) (CancelPaymentResponse, error)
+}
+
+// VbanksAPI is the API of the '/vbanks' endpoints.
+type VbanksAPI interface {
+	IssueVbank(ctx context.Context, req IssueVbankRequest) (IssueVbankResponse, error)
+	UpdateVbank(ctx context.Context, req UpdateVbankRequest) (UpdateVbankResponse, error)
+	DeleteVbank(ctx context.Context, impUID string) (DeleteVbankResponse, error)
+	GetVbankHolder(ctx context.Context, req GetVbankHolderRequest) (GetVbankHolderResponse, error)
+}
+
+// ReceiptsAPI is the API of the '/receipts' endpoints.
+type ReceiptsAPI interface {
+	GetReceipt(ctx context.Context, impUID string) (ReceiptResponse, error)
+	IssueReceipt(ctx context.Context, req IssueReceiptRequest) (ReceiptResponse, error)
+	CancelReceipt(ctx context.Context, impUID string) (ReceiptResponse, error)
+	GetExternalReceipt(ctx context.Context, merchantUID string) (ReceiptResponse, error)
+	IssueExternalReceipt(ctx context.Context, req IssueExternalReceiptRequest) (ReceiptResponse, error)
+	CancelExternalReceipt(ctx context.Context, merchantUID string) (ReceiptResponse, error)
+}
+
+// EscrowsAPI is the API of the '/escrows' endpoints.
+type EscrowsAPI interface {
+	RegisterEscrowLogis(ctx context.Context, req EscrowLogisRequest) (EscrowLogisResponse, error)
+	UpdateEscrowLogis(ctx context.Context, req EscrowLogisRequest) (EscrowLogisResponse, error)
+}
+
+// CodesAPI is the API of the '/banks' and '/cards' endpoints.
+type CodesAPI interface {
+	GetBanks(ctx context.Context) (GetBanksResponse, error)
+	GetBank(ctx context.Context, code string) (GetBankResponse, error)
+	GetCards(ctx context.Context) (GetCardsResponse, error)
+	GetCard(ctx context.Context, code string) (GetCardResponse, error)
+	RefreshCodeTable(ctx context.Context, table *CodeTable) error
+}
+
+// NaverPayAPI is the API of the '/payments/{imp_uid}/naver' and '/naver' endpoints.
+type NaverPayAPI interface {
+	GetNaverProductOrders(ctx context.Context, impUID string) (NaverProductOrdersResponse, error)
+	GetNaverProductOrder(ctx context.Context, productOrderID string) (NaverProductOrderResponse, error)
+	CancelNaverProductOrders(ctx context.Context, req CancelNaverProductOrdersRequest) (NaverProductOrdersResponse, error)
+	ShipNaverProductOrders(ctx context.Context, req ShipNaverProductOrdersRequest) (NaverProductOrdersResponse, error)
+	PlaceNaverProductOrders(ctx context.Context, req NaverProductOrdersRequest) (NaverProductOrdersResponse, error)
+	ApproveCancelNaverProductOrders(ctx context.Context, req NaverProductOrdersRequest) (NaverProductOrdersResponse, error)
+	ApproveReturnNaverProductOrders(ctx context.Context, req NaverReturnRequest) (NaverProductOrdersResponse, error)
+	RejectReturnNaverProductOrders(ctx context.Context, req NaverReturnRequest) (NaverProductOrdersResponse, error)
+	WithholdReturnNaverProductOrders(ctx context.Context, req WithholdReturnNaverProductOrdersRequest) (NaverProductOrdersResponse, error)
+	GetNaverReviews(ctx context.Context, req GetNaverReviewsRequest) (GetNaverReviewsResponse, error)
+}
+
+// KakaoPayAPI is the API of the '/kakao' endpoints.
+type KakaoPayAPI interface {
+	GetKakaoPayOrders(ctx context.Context, req GetKakaoPayOrdersRequest) (GetKakaoPayOrdersResponse, error)
+}
+
+// PaycoAPI is the API of the '/payco' endpoints.
+type PaycoAPI interface {
+	UpdatePaycoOrderStatus(ctx context.Context, req UpdatePaycoOrderStatusRequest) (UpdatePaycoOrderStatusResponse, error)
+}
+
+// TiersAPI is the API of the '/tiers' endpoints.
+type TiersAPI interface {
+	GetTier(ctx context.Context, tierCode string) (GetTierResponse, error)
+}
+
+// SubscribeAPI is the API of the '/subscribe' endpoints.
+type SubscribeAPI interface {
+	PayAgain(ctx context.Context, req PayAgainRequest) (PayAgainResponse, error)
+}
+
+// API is the whole PortOne V1 API, implemented by Client.
+//
+// Code using the client should prefer depending on the smaller interfaces it needs,
+// which can be substituted with the implementation of the mock package in tests.
+type API interface {
+	AuthAPI
+	PaymentsAPI
+	VbanksAPI
+	ReceiptsAPI
+	EscrowsAPI
+	CodesAPI
+	NaverPayAPI
+	KakaoPayAPI
+	PaycoAPI
+	TiersAPI
+	SubscribeAPI
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,38 @@
+package portone_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+// TestAPI makes sure every method of the client is part of an interface, so that it can be mocked.
+func TestAPI(t *testing.T) {
+	clientType := reflect.TypeOf((*portone.Client)(nil))
+	apiType := reflect.TypeOf((*portone.API)(nil)).Elem()
+
+	for i := 0; i < clientType.NumMethod(); i++ {
+		m := clientType.Method(i)
+
+		apiMethod, ok := apiType.MethodByName(m.Name)
+		if !ok {
+			t.Errorf("method %s of the client is missing from the API interface", m.Name)
+			continue
+		}
+
+		// Drop the receiver of the client method before comparing the signatures.
+		in := make([]reflect.Type, 0, m.Type.NumIn()-1)
+		for j := 1; j < m.Type.NumIn(); j++ {
+			in = append(in, m.Type.In(j))
+		}
+		out := make([]reflect.Type, 0, m.Type.NumOut())
+		for j := 0; j < m.Type.NumOut(); j++ {
+			out = append(out, m.Type.Out(j))
+		}
+
+		if got := reflect.FuncOf(in, out, m.Type.IsVariadic()); got != apiMethod.Type {
+			t.Errorf("unexpected signature of %s: client=%s api=%s", m.Name, got, apiMethod.Type)
+		}
+	}
+}
@@ -36,8 +36,11 @@ type GetTokenResponse struct {
 
 // GetToken returns a new token.
 func (as *authenticateService) GetToken(ctx context.Context, req GetTokenRequest) (GetTokenResponse, error) {
+	// Tokens are issued to the merchant and shared by the requests of every tier.
+	ctx = ContextWithTier(ctx, "")
+
 	u := as.baseURL.JoinPath("/getToken")
-	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	httpReq, err := newRequest(ctx, "", http.MethodPost, u.String(), req)
 	if err != nil {
 		return GetTokenResponse{}, err
 	}
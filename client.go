@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 )
 
@@ -14,6 +17,15 @@ const (
 	defaultBaseURL          = "https://api.iamport.kr"
 	authenticateServicePath = "/users"
 	paymentsServicePath     = "/payments"
+	vbanksServicePath       = "/vbanks"
+	receiptsServicePath     = "/receipts"
+	escrowsServicePath      = "/escrows"
+	codesServicePath        = "/"
+	naverPayServicePath     = "/"
+	kakaoPayServicePath     = "/kakao"
+	paycoServicePath        = "/payco"
+	tiersServicePath        = "/tiers"
+	subscribeServicePath    = "/subscribe"
 )
 
 var (
@@ -28,6 +40,9 @@ var defaultClientConfig = clientConfig{
 type clientConfig struct {
 	baseURL string
 	timeout time.Duration
+	tier    string
+
+	credentials map[string]credential
 }
 
 type ClientOption func(*clientConfig)
@@ -44,12 +59,45 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithTier makes every request of the client act on behalf of the sub-merchant identified by tierCode.
+// It can be overridden per call with ContextWithTier.
+func WithTier(tierCode string) ClientOption {
+	return func(c *clientConfig) {
+		c.tier = tierCode
+	}
+}
+
+// WithCredential registers an additional named REST API key and secret, for instance those of another merchant.
+// The name must not be empty, since the empty name refers to the credential given to NewClient.
+// Requests use it when their context is built with ContextWithCredential, and its access token is cached
+// independently from the one of the default credential.
+func WithCredential(name, restAPIKey, restAPISecret string) ClientOption {
+	return func(c *clientConfig) {
+		if c.credentials == nil {
+			c.credentials = make(map[string]credential)
+		}
+		c.credentials[name] = credential{
+			restAPIKey:    restAPIKey,
+			restAPISecret: restAPISecret,
+		}
+	}
+}
+
 // Client is a client for Portone API.
 type Client struct {
 	clientConfig
 
 	*authenticateService
 	*paymentsService
+	*vbanksService
+	*receiptsService
+	*escrowsService
+	*codesService
+	*naverPayService
+	*kakaoPayService
+	*paycoService
+	*tiersService
+	*subscribeService
 }
 
 // NewClient returns a new PortOne API client.
@@ -70,29 +118,74 @@ func NewClient(restAPIKey, restAPISecret string, opts ...ClientOption) (*Client,
 		return nil, err
 	}
 
+	if _, ok := cfg.credentials[defaultCredentialName]; ok {
+		return nil, errors.New("portone: credential name must not be empty")
+	}
+
+	credentials := map[string]credential{
+		defaultCredentialName: {restAPIKey: restAPIKey, restAPISecret: restAPISecret},
+	}
+	for name, cred := range cfg.credentials {
+		credentials[name] = cred
+	}
+
 	httpClient := &http.Client{
-		Timeout: defaultClientTimeout,
-		Transport: &roundTripperWithToken{
-			authenticateService: authenticateService,
-			restAPIKey:          restAPIKey,
-			restAPISecret:       restAPISecret,
-		},
+		Timeout:   defaultClientTimeout,
+		Transport: newRoundTripperWithToken(authenticateService, credentials),
 	}
 
 	paymentsServiceBaseURL := u.JoinPath(paymentsServicePath)
-	paymentsService := newPaymentsService(paymentsServiceBaseURL, httpClient)
+	paymentsService := newPaymentsService(paymentsServiceBaseURL, httpClient, cfg.tier)
 	if err != nil {
 		return nil, err
 	}
 
+	vbanksServiceBaseURL := u.JoinPath(vbanksServicePath)
+	vbanksService := newVbanksService(vbanksServiceBaseURL, httpClient, cfg.tier)
+
+	receiptsServiceBaseURL := u.JoinPath(receiptsServicePath)
+	receiptsService := newReceiptsService(receiptsServiceBaseURL, httpClient, cfg.tier)
+
+	escrowsServiceBaseURL := u.JoinPath(escrowsServicePath)
+	escrowsService := newEscrowsService(escrowsServiceBaseURL, httpClient, cfg.tier)
+
+	codesServiceBaseURL := u.JoinPath(codesServicePath)
+	codesService := newCodesService(codesServiceBaseURL, httpClient, cfg.tier)
+
+	naverPayServiceBaseURL := u.JoinPath(naverPayServicePath)
+	naverPayService := newNaverPayService(naverPayServiceBaseURL, httpClient, cfg.tier)
+
+	kakaoPayServiceBaseURL := u.JoinPath(kakaoPayServicePath)
+	kakaoPayService := newKakaoPayService(kakaoPayServiceBaseURL, httpClient, cfg.tier)
+
+	paycoServiceBaseURL := u.JoinPath(paycoServicePath)
+	paycoService := newPaycoService(paycoServiceBaseURL, httpClient, cfg.tier)
+
+	tiersServiceBaseURL := u.JoinPath(tiersServicePath)
+	tiersService := newTiersService(tiersServiceBaseURL, httpClient, cfg.tier)
+
+	subscribeServiceBaseURL := u.JoinPath(subscribeServicePath)
+	subscribeService := newSubscribeService(subscribeServiceBaseURL, httpClient, cfg.tier)
+
 	return &Client{
 		clientConfig:        cfg,
 		authenticateService: authenticateService,
 		paymentsService:     paymentsService,
+		vbanksService:       vbanksService,
+		receiptsService:     receiptsService,
+		escrowsService:      escrowsService,
+		codesService:        codesService,
+		naverPayService:     naverPayService,
+		kakaoPayService:     kakaoPayService,
+		paycoService:        paycoService,
+		tiersService:        tiersService,
+		subscribeService:    subscribeService,
 	}, nil
 }
 
-func newRequest(ctx context.Context, method, urlStr string, body any) (*http.Request, error) {
+// newRequest returns a request to PortOne. It acts on behalf of the sub-merchant set on ctx by ContextWithTier, if any,
+// or else on behalf of clientTier.
+func newRequest(ctx context.Context, clientTier, method, urlStr string, body any) (*http.Request, error) {
 	var buf io.ReadWriter
 	if body != nil {
 		buf = &bytes.Buffer{}
@@ -108,6 +201,13 @@ func newRequest(ctx context.Context, method, urlStr string, body any) (*http.Req
 
 	httpReq.Header.Set("Content-Type", "application/json")
 	httpReq.Header.Set("Accept", "application/json")
+	tierCode, ok := tierFromContext(ctx)
+	if !ok {
+		tierCode = clientTier
+	}
+	if tierCode != "" {
+		httpReq.Header.Set(tierHeader, tierCode)
+	}
 
 	return httpReq, nil
 }
@@ -129,43 +229,101 @@ func do(httpClient *http.Client, req *http.Request, respBody any) error {
 
 type roundTripperWithToken struct {
 	authenticateService *authenticateService
-	restAPIKey          string
-	restAPISecret       string
-	accessToken         string
-	expireAt            int64
+	tokens              map[string]*credentialToken
+}
+
+func newRoundTripperWithToken(authenticateService *authenticateService, credentials map[string]credential) *roundTripperWithToken {
+	tokens := make(map[string]*credentialToken, len(credentials))
+	for name, cred := range credentials {
+		tokens[name] = &credentialToken{credential: cred}
+	}
+
+	return &roundTripperWithToken{
+		authenticateService: authenticateService,
+		tokens:              tokens,
+	}
 }
 
 func (rt *roundTripperWithToken) RoundTrip(req *http.Request) (*http.Response, error) {
-	if !rt.isAuthenticated() || rt.isAccessTokenExpired() {
-		resp, err := rt.authenticateService.GetToken(context.Background(), GetTokenRequest{
-			RestAPIKey:    rt.restAPIKey,
-			RestAPISecret: rt.restAPISecret,
+	name := credentialFromContext(req.Context())
+	ct, ok := rt.tokens[name]
+	if !ok {
+		return nil, fmt.Errorf("portone: unknown credential %q", name)
+	}
+
+	accessToken, err := ct.token(req.Context(), rt.authenticateService)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", accessToken)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// credentialToken caches the access token issued for a credential.
+type credentialToken struct {
+	credential
+
+	mu          sync.Mutex
+	accessToken string
+	expireAt    int64
+}
+
+func (ct *credentialToken) token(ctx context.Context, as *authenticateService) (string, error) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	if !ct.isAuthenticated() || ct.isAccessTokenExpired() {
+		resp, err := as.GetToken(ctx, GetTokenRequest{
+			RestAPIKey:    ct.restAPIKey,
+			RestAPISecret: ct.restAPISecret,
 		})
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
-		rt.setToken(resp.Response.AccessToken, resp.Response.ExpiredAt)
+		ct.setToken(resp.Response.AccessToken, resp.Response.ExpiredAt)
 	}
 
-	req.Header.Set("Authorization", rt.accessToken)
-	return http.DefaultTransport.RoundTrip(req)
+	return ct.accessToken, nil
 }
 
-func (rt *roundTripperWithToken) isAuthenticated() bool {
-	return rt.accessToken != ""
+func (ct *credentialToken) isAuthenticated() bool {
+	return ct.accessToken != ""
 }
 
-func (rt *roundTripperWithToken) isAccessTokenExpired() bool {
-	return rt.isAuthenticated() && rt.expireAt <= time.Now().Unix()
+func (ct *credentialToken) isAccessTokenExpired() bool {
+	return ct.isAuthenticated() && ct.expireAt <= time.Now().Unix()
 }
 
-func (rt *roundTripperWithToken) setToken(token string, expireAt int64) {
-	rt.accessToken = token
-	rt.expireAt = expireAt
+func (ct *credentialToken) setToken(token string, expireAt int64) {
+	ct.accessToken = token
+	ct.expireAt = expireAt
 }
 
 type CommonResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
 }
+
+// Err returns an *Error if the response reports a failure, nil otherwise.
+func (r CommonResponse) Err() error {
+	if r.Code == 0 {
+		return nil
+	}
+
+	return &Error{
+		Code:    r.Code,
+		Message: r.Message,
+	}
+}
+
+// Error represents a failure reported by PortOne through the 'code' and 'message' fields of a response.
+type Error struct {
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("portone: code=%d message=%s", e.Code, e.Message)
+}
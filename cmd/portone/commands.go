@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+func (c *cli) token(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("token", "token")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return c.usageError(fs, err)
+	}
+
+	resp, err := c.client.GetToken(ctx, portone.GetTokenRequest{
+		RestAPIKey:    c.cfg.RestAPIKey,
+		RestAPISecret: c.cfg.RestAPISecret,
+	})
+	if err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+
+	return c.out.print(resp.Response, []string{"access_token", "expired_at"}, [][]string{{
+		resp.Response.AccessToken,
+		formatUnix(resp.Response.ExpiredAt),
+	}})
+}
+
+func (c *cli) prepare(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("prepare", "prepare -merchant-uid id -amount n")
+	merchantUID := fs.String("merchant-uid", "", "merchant_uid of the payment")
+	amount := fs.Int64("amount", 0, "amount expected for the payment")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 || *merchantUID == "" || *amount <= 0 {
+		return c.usageError(fs, err)
+	}
+
+	resp, err := c.client.CreatePaymentIntent(ctx, portone.CreatePaymentIntentRequest{
+		MerchantUID: *merchantUID,
+		Amount:      *amount,
+	})
+	if err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+
+	return c.out.print(resp.Response, []string{"merchant_uid", "amount"}, [][]string{{
+		resp.Response.MerchantUID,
+		strconv.FormatInt(resp.Response.Amount, 10),
+	}})
+}
+
+func (c *cli) payment(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintln(c.stderr, "Usage: portone payment get|find|list|cancel [arguments]")
+		return errUsage
+	}
+
+	switch args[0] {
+	case "get":
+		return c.paymentGet(ctx, args[1:])
+	case "find":
+		return c.paymentFind(ctx, args[1:])
+	case "list":
+		return c.paymentList(ctx, args[1:])
+	case "cancel":
+		return c.paymentCancel(ctx, args[1:])
+	default:
+		fmt.Fprintf(c.stderr, "unknown payment command %q\n", args[0])
+		fmt.Fprintln(c.stderr, "Usage: portone payment get|find|list|cancel [arguments]")
+		return errUsage
+	}
+}
+
+func (c *cli) paymentGet(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("payment get", "payment get <imp_uid>")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return c.usageError(fs, err)
+	}
+
+	resp, err := c.client.GetPayment(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+
+	return c.out.printPayments(resp.Response, resp.Response)
+}
+
+func (c *cli) paymentFind(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("payment find", "payment find -merchant-uid id")
+	merchantUID := fs.String("merchant-uid", "", "merchant_uid of the payment")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 || *merchantUID == "" {
+		return c.usageError(fs, err)
+	}
+
+	resp, err := c.client.FindPayment(ctx, *merchantUID)
+	if err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+
+	return c.out.printPayments(resp.Response, resp.Response)
+}
+
+func (c *cli) paymentList(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("payment list", "payment list [-status s] [-from t] [-to t] [-page n] [-limit n] [-sorting s]")
+	status := fs.String("status", portone.PaymentStatusAll, "ready, paid, cancelled, failed or all")
+	from := fs.String("from", "", "lower bound of the last status change, as YYYY-MM-DD or RFC 3339")
+	to := fs.String("to", "", "upper bound of the last status change, as YYYY-MM-DD or RFC 3339")
+	page := fs.Int("page", 1, "page to fetch, starting at 1")
+	limit := fs.Int("limit", 20, "number of payments per page, up to 100")
+	sorting := fs.String("sorting", "", "-started, started, -paid, paid, -updated or updated")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return c.usageError(fs, err)
+	}
+
+	req := portone.GetPaymentsByStatusRequest{
+		Status:  *status,
+		Page:    *page,
+		Limit:   *limit,
+		Sorting: *sorting,
+	}
+	var err error
+	if req.From, err = parseTime(*from); err != nil {
+		return fmt.Errorf("invalid -from: %w", err)
+	}
+	if req.To, err = parseTime(*to); err != nil {
+		return fmt.Errorf("invalid -to: %w", err)
+	}
+
+	resp, err := c.client.GetPaymentsByStatus(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+
+	if resp.HasNextPage() {
+		fmt.Fprintf(c.stderr, "%d payments in total, use -page %d to see more\n", resp.Response.Total, resp.Response.Next)
+	}
+
+	return c.out.printPayments(resp.Response, resp.Response.List...)
+}
+
+func (c *cli) paymentCancel(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("payment cancel", "payment cancel [-amount n] [-checksum n] -reason r <imp_uid>")
+	amount := fs.Int64("amount", 0, "positive amount to cancel, the whole payment when omitted")
+	checksum := fs.Int64("checksum", -1, "cancellable amount of the payment, the cancellation fails if it differs")
+	reason := fs.String("reason", "", "reason of the cancellation")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 || *reason == "" {
+		return c.usageError(fs, err)
+	}
+
+	// A zero amount means a full cancellation to PortOne, so only an omitted -amount may ask for one.
+	var amountSet bool
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "amount" {
+			amountSet = true
+		}
+	})
+	if amountSet && *amount <= 0 {
+		return c.usageError(fs, nil)
+	}
+
+	req := portone.CancelPaymentRequest{
+		ImpUID: fs.Arg(0),
+		Amount: *amount,
+		Reason: *reason,
+	}
+	if *checksum >= 0 {
+		req.Checksum = checksum
+	}
+
+	resp, err := c.client.CancelPayment(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+
+	return c.out.printPayments(resp.Response, resp.Response)
+}
+
+// usageError prints the usage of a subcommand unless the flag package already did it while failing to parse.
+func (c *cli) usageError(fs *flag.FlagSet, parseErr error) error {
+	if parseErr != nil {
+		return parseError(parseErr)
+	}
+
+	fs.Usage()
+	return errUsage
+}
+
+// parseTime parses a date in the local time zone or an RFC 3339 time into a UNIX timestamp. Empty means unset.
+func parseTime(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	if t, err := time.ParseInLocation(time.DateOnly, s, time.Local); err == nil {
+		return t.Unix(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.Unix(), nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// config holds the settings of the cli. Environment variables take precedence over the config file.
+type config struct {
+	RestAPIKey    string `json:"rest_api_key"`
+	RestAPISecret string `json:"rest_api_secret"`
+	Tier          string `json:"tier"`
+	// BaseURL overrides the URL of the PortOne API, for instance to target a proxy.
+	BaseURL string `json:"base_url"`
+}
+
+const (
+	envRestAPIKey    = "PORTONE_API_KEY"
+	envRestAPISecret = "PORTONE_API_SECRET"
+	envTier          = "PORTONE_TIER"
+	envBaseURL       = "PORTONE_BASE_URL"
+)
+
+// loadConfig reads the config file at path, if any, and overrides it with the environment.
+// An empty path reads the default config file, which may not exist.
+func loadConfig(path string, getenv func(string) string) (config, error) {
+	var cfg config
+
+	explicit := path != ""
+	if !explicit {
+		dir, err := os.UserConfigDir()
+		if err == nil {
+			path = filepath.Join(dir, "portone", "config.json")
+		}
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && !explicit:
+		case err != nil:
+			return config{}, err
+		default:
+			if err := json.Unmarshal(data, &cfg); err != nil {
+				return config{}, fmt.Errorf("reading %s: %w", path, err)
+			}
+		}
+	}
+
+	for env, v := range map[string]*string{
+		envRestAPIKey:    &cfg.RestAPIKey,
+		envRestAPISecret: &cfg.RestAPISecret,
+		envTier:          &cfg.Tier,
+		envBaseURL:       &cfg.BaseURL,
+	} {
+		if s := getenv(env); s != "" {
+			*v = s
+		}
+	}
+
+	if cfg.RestAPIKey == "" || cfg.RestAPISecret == "" {
+		return config{}, fmt.Errorf("missing credentials: set %s and %s or write them to the config file", envRestAPIKey, envRestAPISecret)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	err := os.WriteFile(path, []byte(`{"rest_api_key": "file_key", "rest_api_secret": "file_secret", "tier": "file_tier"}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	env := map[string]string{envRestAPISecret: "env_secret"}
+	cfg, err := loadConfig(path, func(key string) string { return env[key] })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := config{RestAPIKey: "file_key", RestAPISecret: "env_secret", Tier: "file_tier"}
+	if diff := cmp.Diff(want, cfg); diff != "" {
+		t.Errorf("unexpected config (-want +got):\n%s", diff)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	noEnv := func(string) string { return "" }
+
+	_, err := loadConfig(filepath.Join(t.TempDir(), "missing.json"), noEnv)
+	if !os.IsNotExist(err) {
+		t.Errorf("expected a missing explicit config file to fail, got %v", err)
+	}
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	_, err = loadConfig("", noEnv)
+	if err == nil {
+		t.Errorf("expected missing credentials to fail")
+	}
+}
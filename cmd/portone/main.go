@@ -0,0 +1,152 @@
+// Command portone looks up, prepares and cancels PortOne V1 payments from the command line.
+//
+// Usage:
+//
+//	portone [-config file] [-format table|json|csv] [-tier code] <command> [arguments]
+//
+// The commands are:
+//
+//	token                                        issue an access token
+//	prepare -merchant-uid id -amount n           register the amount expected for a payment
+//	payment get <imp_uid>                        show a payment
+//	payment find -merchant-uid id                show the latest payment of a merchant_uid
+//	payment list [-status s] [-from t] [-to t]   list payments by status
+//	payment cancel [-amount n] -reason r <imp_uid>
+//	                                             cancel a payment fully or partially
+//
+// The REST API key and secret are read from the PORTONE_API_KEY and PORTONE_API_SECRET environment
+// variables, or else from a JSON config file which defaults to portone/config.json in the user config directory:
+//
+//	{"rest_api_key": "...", "rest_api_secret": "...", "tier": "..."}
+//
+// PORTONE_TIER and PORTONE_BASE_URL likewise override the tier and base_url settings of the file.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+const usage = `Usage: portone [-config file] [-format table|json|csv] [-tier code] <command> [arguments]
+
+Commands:
+  token                                        issue an access token
+  prepare -merchant-uid id -amount n           register the amount expected for a payment
+  payment get <imp_uid>                        show a payment
+  payment find -merchant-uid id                show the latest payment of a merchant_uid
+  payment list [-status s] [-from t] [-to t]   list payments by status
+  payment cancel [-amount n] -reason r <imp_uid>
+                                               cancel a payment fully or partially
+
+Credentials are read from PORTONE_API_KEY and PORTONE_API_SECRET, or else from the config file.
+`
+
+// errUsage is returned when the command line is invalid. The usage has already been printed.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := run(ctx, os.Args[1:], os.Getenv, os.Stdout, os.Stderr)
+	if errors.Is(err, errUsage) {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "portone: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// cli holds what the commands need once the global flags are parsed.
+type cli struct {
+	client *portone.Client
+	cfg    config
+	out    *printer
+	stderr io.Writer
+}
+
+func run(ctx context.Context, args []string, getenv func(string) string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("portone", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, usage) }
+	configPath := fs.String("config", "", "path of the JSON config file")
+	format := fs.String("format", formatTable, "output format: table, json or csv")
+	tier := fs.String("tier", "", "code of the sub-merchant to act on behalf of")
+	if err := fs.Parse(args); err != nil {
+		return parseError(err)
+	}
+
+	out, err := newPrinter(stdout, *format)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return errUsage
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	cfg, err := loadConfig(*configPath, getenv)
+	if err != nil {
+		return err
+	}
+	if *tier != "" {
+		cfg.Tier = *tier
+	}
+
+	opts := []portone.ClientOption{portone.WithTier(cfg.Tier)}
+	if cfg.BaseURL != "" {
+		opts = append(opts, portone.WithBaseURL(cfg.BaseURL))
+	}
+	client, err := portone.NewClient(cfg.RestAPIKey, cfg.RestAPISecret, opts...)
+	if err != nil {
+		return err
+	}
+
+	c := &cli{client: client, cfg: cfg, out: out, stderr: stderr}
+
+	cmd, cmdArgs := fs.Arg(0), fs.Args()[1:]
+	switch cmd {
+	case "token":
+		return c.token(ctx, cmdArgs)
+	case "prepare":
+		return c.prepare(ctx, cmdArgs)
+	case "payment":
+		return c.payment(ctx, cmdArgs)
+	default:
+		fmt.Fprintf(stderr, "unknown command %q\n\n", cmd)
+		fs.Usage()
+		return errUsage
+	}
+}
+
+// parseError returns the error to report for a failed parsing of the flags, whose message has been printed already.
+// Asking for the help is not a failure.
+func parseError(err error) error {
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	return errUsage
+}
+
+// newFlagSet returns a flag set for a subcommand which reports errors and its usage to the stderr of the cli.
+func (c *cli) newFlagSet(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: portone %s\n", usage)
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
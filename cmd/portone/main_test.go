@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testPaymentJSON = `{
+	"imp_uid": "test_imp_uid",
+	"merchant_uid": "test_merchant_uid",
+	"pay_method": "card",
+	"pg_provider": "nice",
+	"name": "test_name",
+	"amount": 1000,
+	"cancel_amount": 0,
+	"currency": "KRW",
+	"status": "paid",
+	"paid_at": 1700000000
+}`
+
+func newTestServer(t *testing.T) (*http.ServeMux, func(string) string) {
+	t.Helper()
+
+	// Keep the config file of the user out of the tests.
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("/users/getToken", func(w http.ResponseWriter, r *http.Request) {
+		var req portone.GetTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		if req.RestAPIKey != "test_key" || req.RestAPISecret != "test_secret" {
+			t.Errorf("unexpected credentials: %+v", req)
+		}
+
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {"access_token": "test_access_token", "now": 1600000000, "expired_at": 4102444800}
+		}`))
+	})
+
+	env := map[string]string{
+		envRestAPIKey:    "test_key",
+		envRestAPISecret: "test_secret",
+		envBaseURL:       srv.URL,
+	}
+
+	return mux, func(key string) string { return env[key] }
+}
+
+func runTest(t *testing.T, getenv func(string) string, args ...string) (string, string, error) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	err := run(context.Background(), args, getenv, &stdout, &stderr)
+
+	return stdout.String(), stderr.String(), err
+}
+
+func TestPaymentGet(t *testing.T) {
+	mux, getenv := newTestServer(t)
+
+	mux.HandleFunc("/payments/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		_, _ = w.Write([]byte(`{"code": 0, "message": "success", "response": ` + testPaymentJSON + `}`))
+	})
+
+	stdout, _, err := runTest(t, getenv, "payment", "get", "test_imp_uid")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paidAt := time.Unix(1700000000, 0).Format(time.RFC3339)
+	want := "imp_uid       merchant_uid       status  amount  cancel_amount  currency  pay_method  pg_provider  name       paid_at\n" +
+		"test_imp_uid  test_merchant_uid  paid    1000    0              KRW       card        nice         test_name  " + paidAt + "\n"
+	if diff := cmp.Diff(want, stdout); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+}
+
+func TestPaymentFind(t *testing.T) {
+	mux, getenv := newTestServer(t)
+
+	mux.HandleFunc("/payments/find/test_merchant_uid", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"code": 0, "message": "success", "response": ` + testPaymentJSON + `}`))
+	})
+
+	stdout, _, err := runTest(t, getenv, "-format", "json", "payment", "find", "-merchant-uid", "test_merchant_uid")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got portone.Payment
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ImpUID != "test_imp_uid" || got.Amount != 1000 {
+		t.Errorf("unexpected payment: %+v", got)
+	}
+}
+
+func TestPaymentList(t *testing.T) {
+	mux, getenv := newTestServer(t)
+
+	from, _ := time.ParseInLocation(time.DateOnly, "2024-01-01", time.Local)
+	mux.HandleFunc("/payments/status/paid", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("from") != strconv.FormatInt(from.Unix(), 10) || q.Get("to") != "1704153600" {
+			t.Errorf("unexpected period: %s", r.URL.RawQuery)
+		}
+		if q.Get("page") != "2" || q.Get("limit") != "20" {
+			t.Errorf("unexpected page: %s", r.URL.RawQuery)
+		}
+
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {"total": 41, "previous": 1, "next": 3, "list": [` + testPaymentJSON + `]}
+		}`))
+	})
+
+	stdout, stderr, err := runTest(t, getenv,
+		"-format", "csv",
+		"payment", "list", "-status", "paid", "-from", "2024-01-01", "-to", "2024-01-02T00:00:00Z", "-page", "2",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paidAt := time.Unix(1700000000, 0).Format(time.RFC3339)
+	want := "imp_uid,merchant_uid,status,amount,cancel_amount,currency,pay_method,pg_provider,name,paid_at\n" +
+		"test_imp_uid,test_merchant_uid,paid,1000,0,KRW,card,nice,test_name," + paidAt + "\n"
+	if diff := cmp.Diff(want, stdout); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+
+	if !strings.Contains(stderr, "-page 3") {
+		t.Errorf("expected a hint about the next page, got %q", stderr)
+	}
+}
+
+func TestPaymentCancel(t *testing.T) {
+	mux, getenv := newTestServer(t)
+
+	mux.HandleFunc("/payments/cancel", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var got portone.CancelPaymentRequest
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+
+		checksum := int64(1000)
+		want := portone.CancelPaymentRequest{ImpUID: "test_imp_uid", Amount: 300, Checksum: &checksum, Reason: "test_reason"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unexpected request (-want +got):\n%s", diff)
+		}
+
+		_, _ = w.Write([]byte(`{"code": 0, "message": "success", "response": ` + testPaymentJSON + `}`))
+	})
+
+	_, _, err := runTest(t, getenv, "payment", "cancel", "-amount", "300", "-checksum", "1000", "-reason", "test_reason", "test_imp_uid")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPaymentCancelFailure(t *testing.T) {
+	mux, getenv := newTestServer(t)
+
+	mux.HandleFunc("/payments/cancel", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"code": -1, "message": "이미 전액취소된 주문입니다.", "response": null}`))
+	})
+
+	_, _, err := runTest(t, getenv, "payment", "cancel", "-reason", "test_reason", "test_imp_uid")
+
+	var portoneErr *portone.Error
+	if !errors.As(err, &portoneErr) || portoneErr.Code != -1 {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	mux, getenv := newTestServer(t)
+
+	mux.HandleFunc("/payments/prepare", func(w http.ResponseWriter, r *http.Request) {
+		var got portone.CreatePaymentIntentRequest
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		if got.MerchantUID != "test_merchant_uid" || got.Amount != 1000 {
+			t.Errorf("unexpected request: %+v", got)
+		}
+
+		_, _ = w.Write([]byte(`{"code": 0, "message": "success", "response": {"merchant_uid": "test_merchant_uid", "amount": 1000}}`))
+	})
+
+	stdout, _, err := runTest(t, getenv, "-format", "csv", "prepare", "-merchant-uid", "test_merchant_uid", "-amount", "1000")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "merchant_uid,amount\ntest_merchant_uid,1000\n"; stdout != want {
+		t.Errorf("unexpected output: %q", stdout)
+	}
+}
+
+func TestToken(t *testing.T) {
+	_, getenv := newTestServer(t)
+
+	stdout, _, err := runTest(t, getenv, "-format", "csv", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "access_token,expired_at\ntest_access_token," + time.Unix(4102444800, 0).Format(time.RFC3339) + "\n"
+	if stdout != want {
+		t.Errorf("unexpected output: %q", stdout)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	_, getenv := newTestServer(t)
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "no command", args: nil},
+		{name: "unknown command", args: []string{"refund"}},
+		{name: "unknown format", args: []string{"-format", "xml", "token"}},
+		{name: "missing imp_uid", args: []string{"payment", "get"}},
+		{name: "missing reason", args: []string{"payment", "cancel", "test_imp_uid"}},
+		{name: "zero cancel amount", args: []string{"payment", "cancel", "-amount", "0", "-reason", "test_reason", "test_imp_uid"}},
+		{name: "negative cancel amount", args: []string{"payment", "cancel", "-amount", "-1", "-reason", "test_reason", "test_imp_uid"}},
+		{name: "unknown flag", args: []string{"payment", "find", "-imp-uid", "test_imp_uid"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, stderr, err := runTest(t, getenv, tt.args...)
+			if !errors.Is(err, errUsage) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if stderr == "" {
+				t.Errorf("expected the usage to be printed")
+			}
+		})
+	}
+}
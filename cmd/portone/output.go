@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// printer writes the results of the commands in the chosen format.
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	switch format {
+	case formatTable, formatJSON, formatCSV:
+		return &printer{w: w, format: format}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q: expected table, json or csv", format)
+	}
+}
+
+// print writes v as indented JSON, or as the given rows for the table and CSV formats.
+func (p *printer) print(v any, header []string, rows [][]string) error {
+	switch p.format {
+	case formatJSON:
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatCSV:
+		w := csv.NewWriter(p.w)
+		_ = w.Write(header)
+		_ = w.WriteAll(rows)
+		return w.Error()
+	default:
+		w := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+		for _, row := range rows {
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	}
+}
+
+var paymentHeader = []string{
+	"imp_uid",
+	"merchant_uid",
+	"status",
+	"amount",
+	"cancel_amount",
+	"currency",
+	"pay_method",
+	"pg_provider",
+	"name",
+	"paid_at",
+}
+
+func (p *printer) printPayments(v any, payments ...portone.Payment) error {
+	rows := make([][]string, 0, len(payments))
+	for _, payment := range payments {
+		rows = append(rows, []string{
+			payment.ImpUID,
+			payment.MerchantUID,
+			payment.Status,
+			strconv.Itoa(payment.Amount),
+			strconv.Itoa(payment.CancelAmount),
+			payment.Currency,
+			payment.PayMethod,
+			payment.PgProvider,
+			payment.Name,
+			formatUnix(int64(payment.PaidAt)),
+		})
+	}
+
+	return p.print(v, paymentHeader, rows)
+}
+
+// formatUnix formats a UNIX timestamp of the API in the local time zone. Zero means unset.
+func formatUnix(sec int64) string {
+	if sec == 0 {
+		return ""
+	}
+
+	return time.Unix(sec, 0).Format(time.RFC3339)
+}
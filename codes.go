@@ -0,0 +1,221 @@
+package portone
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+//go:embed codes.json
+var embeddedCodes []byte
+
+type codesService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	tier       string
+}
+
+func newCodesService(baseURL *url.URL, httpClient *http.Client, tier string) *codesService {
+	return &codesService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		tier:       tier,
+	}
+}
+
+// Bank represents a bank standard code and its name.
+type Bank struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// Card represents a card company standard code and its name.
+type Card struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// GetBanksResponse represents a response of 'GET /banks'.
+type GetBanksResponse struct {
+	CommonResponse
+	Response []Bank `json:"response"`
+}
+
+// GetBanks returns every bank code supported by PortOne.
+func (cs *codesService) GetBanks(ctx context.Context) (GetBanksResponse, error) {
+	var resp GetBanksResponse
+	err := cs.get(ctx, cs.baseURL.JoinPath("/banks"), &resp)
+	if err != nil {
+		return GetBanksResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetBankResponse represents a response of 'GET /banks/{bank_standard_code}'.
+type GetBankResponse struct {
+	CommonResponse
+	Response Bank `json:"response"`
+}
+
+// GetBank returns the bank matching the given standard code.
+func (cs *codesService) GetBank(ctx context.Context, code string) (GetBankResponse, error) {
+	var resp GetBankResponse
+	err := cs.get(ctx, cs.baseURL.JoinPath("/banks", code), &resp)
+	if err != nil {
+		return GetBankResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetCardsResponse represents a response of 'GET /cards'.
+type GetCardsResponse struct {
+	CommonResponse
+	Response []Card `json:"response"`
+}
+
+// GetCards returns every card company code supported by PortOne.
+func (cs *codesService) GetCards(ctx context.Context) (GetCardsResponse, error) {
+	var resp GetCardsResponse
+	err := cs.get(ctx, cs.baseURL.JoinPath("/cards"), &resp)
+	if err != nil {
+		return GetCardsResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetCardResponse represents a response of 'GET /cards/{card_standard_code}'.
+type GetCardResponse struct {
+	CommonResponse
+	Response Card `json:"response"`
+}
+
+// GetCard returns the card company matching the given standard code.
+func (cs *codesService) GetCard(ctx context.Context, code string) (GetCardResponse, error) {
+	var resp GetCardResponse
+	err := cs.get(ctx, cs.baseURL.JoinPath("/cards", code), &resp)
+	if err != nil {
+		return GetCardResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// RefreshCodeTable replaces the content of the given table with the codes currently served by PortOne.
+func (cs *codesService) RefreshCodeTable(ctx context.Context, table *CodeTable) error {
+	banks, err := cs.GetBanks(ctx)
+	if err != nil {
+		return err
+	}
+	if err := banks.Err(); err != nil {
+		return err
+	}
+
+	cards, err := cs.GetCards(ctx)
+	if err != nil {
+		return err
+	}
+	if err := cards.Err(); err != nil {
+		return err
+	}
+
+	table.SetBanks(banks.Response)
+	table.SetCards(cards.Response)
+
+	return nil
+}
+
+func (cs *codesService) get(ctx context.Context, u *url.URL, respBody any) error {
+	httpReq, err := newRequest(ctx, cs.tier, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	return do(cs.httpClient, httpReq, respBody)
+}
+
+// DefaultCodeTable is the code table used by BankName and CardName.
+// It is initialized from the codes embedded in the package.
+var DefaultCodeTable = NewCodeTable()
+
+// BankName returns the name of the bank matching the given code in DefaultCodeTable.
+func BankName(code string) (string, bool) {
+	return DefaultCodeTable.BankName(code)
+}
+
+// CardName returns the name of the card company matching the given code in DefaultCodeTable.
+func CardName(code string) (string, bool) {
+	return DefaultCodeTable.CardName(code)
+}
+
+// CodeTable translates bank and card codes to their names without calling the API.
+// It is safe for concurrent use.
+type CodeTable struct {
+	mu    sync.RWMutex
+	banks map[string]string
+	cards map[string]string
+}
+
+// NewCodeTable returns a new code table initialized from the codes embedded in the package.
+func NewCodeTable() *CodeTable {
+	var codes struct {
+		Banks []Bank `json:"banks"`
+		Cards []Card `json:"cards"`
+	}
+	if err := json.Unmarshal(embeddedCodes, &codes); err != nil {
+		panic("portone: malformed embedded codes: " + err.Error())
+	}
+
+	t := &CodeTable{}
+	t.SetBanks(codes.Banks)
+	t.SetCards(codes.Cards)
+
+	return t
+}
+
+// BankName returns the name of the bank matching the given code.
+func (t *CodeTable) BankName(code string) (string, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	name, ok := t.banks[code]
+	return name, ok
+}
+
+// CardName returns the name of the card company matching the given code.
+func (t *CodeTable) CardName(code string) (string, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	name, ok := t.cards[code]
+	return name, ok
+}
+
+// SetBanks replaces the banks of the table.
+func (t *CodeTable) SetBanks(banks []Bank) {
+	m := make(map[string]string, len(banks))
+	for _, b := range banks {
+		m[b.Code] = b.Name
+	}
+
+	t.mu.Lock()
+	t.banks = m
+	t.mu.Unlock()
+}
+
+// SetCards replaces the card companies of the table.
+func (t *CodeTable) SetCards(cards []Card) {
+	m := make(map[string]string, len(cards))
+	for _, c := range cards {
+		m[c.Code] = c.Name
+	}
+
+	t.mu.Lock()
+	t.cards = m
+	t.mu.Unlock()
+}
@@ -0,0 +1,111 @@
+package portone_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetBank(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/banks/004", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"code": "004",
+				"name": "KB국민은행"
+			}
+		}`))
+	})
+
+	resp, err := client.GetBank(context.Background(), "004")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := portone.GetBankResponse{
+		CommonResponse: portone.CommonResponse{
+			Code:    0,
+			Message: "success",
+		},
+		Response: portone.Bank{
+			Code: "004",
+			Name: "KB국민은행",
+		},
+	}
+
+	if diff := cmp.Diff(want, resp); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
+
+func TestRefreshCodeTable(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/banks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": [
+				{"code": "999", "name": "test_bank"}
+			]
+		}`))
+	})
+
+	mux.HandleFunc("/cards", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": [
+				{"code": "998", "name": "test_card"}
+			]
+		}`))
+	})
+
+	table := portone.NewCodeTable()
+	if name, ok := table.BankName("004"); !ok || name != "KB국민은행" {
+		t.Errorf("unexpected embedded bank name: %q, %v", name, ok)
+	}
+
+	if err := client.RefreshCodeTable(context.Background(), table); err != nil {
+		t.Fatal(err)
+	}
+
+	if name, ok := table.BankName("999"); !ok || name != "test_bank" {
+		t.Errorf("unexpected bank name: %q, %v", name, ok)
+	}
+
+	if name, ok := table.CardName("998"); !ok || name != "test_card" {
+		t.Errorf("unexpected card name: %q, %v", name, ok)
+	}
+
+	if _, ok := table.BankName("004"); ok {
+		t.Error("expected refreshed table to drop stale bank codes")
+	}
+}
+
+func TestCardName(t *testing.T) {
+	name, ok := portone.CardName("366")
+	if !ok || name != "신한카드" {
+		t.Errorf("unexpected card name: %q, %v", name, ok)
+	}
+
+	if _, ok := portone.CardName("000"); ok {
+		t.Error("expected unknown card code to be missing")
+	}
+}
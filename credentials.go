@@ -0,0 +1,24 @@
+package portone
+
+import "context"
+
+// defaultCredentialName is the name of the credential given to NewClient.
+const defaultCredentialName = ""
+
+type credential struct {
+	restAPIKey    string
+	restAPISecret string
+}
+
+type credentialContextKey struct{}
+
+// ContextWithCredential returns a copy of ctx making the requests sent with it authenticate
+// with the credential registered under name by WithCredential.
+func ContextWithCredential(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, credentialContextKey{}, name)
+}
+
+func credentialFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(credentialContextKey{}).(string)
+	return name
+}
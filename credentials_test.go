@@ -0,0 +1,86 @@
+package portone_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+func TestContextWithCredential(t *testing.T) {
+	client, mux := mustInitClient(t, portone.WithCredential("other", "other_rest_api_key", "other_rest_api_secret"))
+
+	var mu sync.Mutex
+	issued := make(map[string]int)
+	mux.HandleFunc("/users/getToken", func(w http.ResponseWriter, r *http.Request) {
+		var req portone.GetTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		mu.Lock()
+		issued[req.RestAPIKey]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"access_token": "token_of_%s",
+				"now": 1600000000,
+				"expired_at": 4102444800
+			}
+		}`, req.RestAPIKey)
+	})
+
+	var gotAuthorization string
+	mux.HandleFunc("/payments/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+		gotAuthorization = r.Header.Get("Authorization")
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"code": 0, "message": "success", "response": {}}`))
+	})
+
+	tests := []struct {
+		ctx  context.Context
+		want string
+	}{
+		{ctx: context.Background(), want: "token_of_" + testRestAPIKey},
+		{ctx: portone.ContextWithCredential(context.Background(), "other"), want: "token_of_other_rest_api_key"},
+		{ctx: context.Background(), want: "token_of_" + testRestAPIKey},
+		{ctx: portone.ContextWithCredential(context.Background(), "other"), want: "token_of_other_rest_api_key"},
+	}
+
+	for _, tt := range tests {
+		if _, err := client.GetPayment(tt.ctx, "test_imp_uid"); err != nil {
+			t.Fatal(err)
+		}
+
+		if gotAuthorization != tt.want {
+			t.Errorf("unexpected authorization: got %q, want %q", gotAuthorization, tt.want)
+		}
+	}
+
+	if issued[testRestAPIKey] != 1 || issued["other_rest_api_key"] != 1 {
+		t.Errorf("expected one token per credential, got %v", issued)
+	}
+
+	_, err := client.GetPayment(portone.ContextWithCredential(context.Background(), "unknown"), "test_imp_uid")
+	if err == nil {
+		t.Error("expected an error for an unknown credential")
+	}
+}
+
+func TestWithCredentialEmptyName(t *testing.T) {
+	_, err := portone.NewClient(testRestAPIKey, testRestAPISecret, portone.WithCredential("", "other_rest_api_key", "other_rest_api_secret"))
+	if err == nil {
+		t.Error("expected an error for an empty credential name")
+	}
+}
@@ -0,0 +1,84 @@
+package portone
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type escrowsService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	tier       string
+}
+
+func newEscrowsService(baseURL *url.URL, httpClient *http.Client, tier string) *escrowsService {
+	return &escrowsService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		tier:       tier,
+	}
+}
+
+// EscrowLogisPerson represents the sender or the receiver of an escrow shipment.
+type EscrowLogisPerson struct {
+	Name     string `json:"name"`
+	Tel      string `json:"tel"`
+	Addr     string `json:"addr"`
+	Postcode string `json:"postcode"`
+}
+
+// EscrowLogisInfo represents the logistics information of an escrow shipment.
+type EscrowLogisInfo struct {
+	// Company is the logistics company code (e.g. "CJGLS", "EPOST", "HANJIN").
+	Company   string `json:"company"`
+	Invoice   string `json:"invoice"`
+	SentAt    int64  `json:"sent_at"`
+	ReceiptAt int64  `json:"receipt_at,omitempty"`
+}
+
+// EscrowLogisRequest represents a request for 'POST /escrows/logis/{imp_uid}' and 'PUT /escrows/logis/{imp_uid}'.
+type EscrowLogisRequest struct {
+	ImpUID   string            `json:"-"`
+	Sender   EscrowLogisPerson `json:"sender"`
+	Receiver EscrowLogisPerson `json:"receiver"`
+	Logis    EscrowLogisInfo   `json:"logis"`
+}
+
+// EscrowLogisResponse represents a response of 'POST /escrows/logis/{imp_uid}' and 'PUT /escrows/logis/{imp_uid}'.
+type EscrowLogisResponse struct {
+	CommonResponse
+	Response struct {
+		Company   string `json:"company"`
+		Invoice   string `json:"invoice"`
+		SentAt    int64  `json:"sent_at"`
+		ReceiptAt int64  `json:"receipt_at"`
+		AppliedAt int64  `json:"applied_at"`
+	} `json:"response"`
+}
+
+// RegisterEscrowLogis registers the shipment of an escrow payment.
+func (es *escrowsService) RegisterEscrowLogis(ctx context.Context, req EscrowLogisRequest) (EscrowLogisResponse, error) {
+	return es.doEscrowLogis(ctx, http.MethodPost, req)
+}
+
+// UpdateEscrowLogis updates the shipment of an escrow payment.
+func (es *escrowsService) UpdateEscrowLogis(ctx context.Context, req EscrowLogisRequest) (EscrowLogisResponse, error) {
+	return es.doEscrowLogis(ctx, http.MethodPut, req)
+}
+
+func (es *escrowsService) doEscrowLogis(ctx context.Context, method string, req EscrowLogisRequest) (EscrowLogisResponse, error) {
+	u := es.baseURL.JoinPath("/logis", req.ImpUID)
+	httpReq, err := newRequest(ctx, es.tier, method, u.String(), req)
+	if err != nil {
+		return EscrowLogisResponse{}, err
+	}
+
+	var resp EscrowLogisResponse
+	err = do(es.httpClient, httpReq, &resp)
+	if err != nil {
+		return EscrowLogisResponse{}, err
+	}
+
+	return resp, nil
+}
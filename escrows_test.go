@@ -0,0 +1,113 @@
+package portone_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegisterEscrowLogis(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	req := portone.EscrowLogisRequest{
+		ImpUID: "test_imp_uid",
+		Sender: portone.EscrowLogisPerson{
+			Name:     "test_sender_name",
+			Tel:      "test_sender_tel",
+			Addr:     "test_sender_addr",
+			Postcode: "test_sender_postcode",
+		},
+		Receiver: portone.EscrowLogisPerson{
+			Name:     "test_receiver_name",
+			Tel:      "test_receiver_tel",
+			Addr:     "test_receiver_addr",
+			Postcode: "test_receiver_postcode",
+		},
+		Logis: portone.EscrowLogisInfo{
+			Company: "CJGLS",
+			Invoice: "test_invoice",
+			SentAt:  1600000000,
+		},
+	}
+
+	mux.HandleFunc("/escrows/logis/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body portone.EscrowLogisRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		body.ImpUID = req.ImpUID
+		if diff := cmp.Diff(req, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"company": "CJGLS",
+				"invoice": "test_invoice",
+				"sent_at": 1600000000,
+				"receipt_at": 0,
+				"applied_at": 1600000100
+			}
+		}`))
+	})
+
+	resp, err := client.RegisterEscrowLogis(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Response.Invoice != "test_invoice" || resp.Response.AppliedAt != 1600_000_100 {
+		t.Errorf("unexpected response: %+v", resp.Response)
+	}
+}
+
+func TestUpdateEscrowLogis(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/escrows/logis/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"company": "HANJIN",
+				"invoice": "test_invoice_2",
+				"sent_at": 1600000000
+			}
+		}`))
+	})
+
+	resp, err := client.UpdateEscrowLogis(context.Background(), portone.EscrowLogisRequest{
+		ImpUID: "test_imp_uid",
+		Logis: portone.EscrowLogisInfo{
+			Company: "HANJIN",
+			Invoice: "test_invoice_2",
+			SentAt:  1600000000,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Response.Company != "HANJIN" {
+		t.Errorf("unexpected company: %s", resp.Response.Company)
+	}
+}
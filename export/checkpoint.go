@@ -0,0 +1,88 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Checkpoint records the progress of an export so that it can be resumed.
+type Checkpoint struct {
+	// From, To and Status identify the export. Resuming with different ones fails.
+	From   int64  `json:"from"`
+	To     int64  `json:"to"`
+	Status string `json:"status"`
+	// NextPage is the next page of payments to export.
+	NextPage int `json:"next_page"`
+	// Payments is the number of payments exported so far.
+	Payments int `json:"payments"`
+	// Offset is the number of bytes written to the output so far. Truncate the output to it before resuming.
+	Offset int64 `json:"offset"`
+	Done   bool  `json:"done"`
+}
+
+// CheckpointStore persists the checkpoint of an export.
+type CheckpointStore interface {
+	// Load returns the saved checkpoint, or false when there is none.
+	Load(ctx context.Context) (Checkpoint, bool, error)
+	Save(ctx context.Context, cp Checkpoint) error
+}
+
+// FileCheckpointStore is a CheckpointStore saving the checkpoint as a JSON file.
+type FileCheckpointStore struct {
+	path string
+}
+
+// NewFileCheckpointStore returns a CheckpointStore saving the checkpoint at path.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load reads the checkpoint file, if it exists.
+func (s *FileCheckpointStore) Load(context.Context) (Checkpoint, bool, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Checkpoint{}, false, nil
+	}
+	if err != nil {
+		return Checkpoint{}, false, err
+	}
+
+	var cp Checkpoint
+	err = json.Unmarshal(data, &cp)
+	if err != nil {
+		return Checkpoint{}, false, err
+	}
+
+	return cp, true, nil
+}
+
+// Save replaces the checkpoint file atomically, so that an interrupted save keeps the previous checkpoint.
+func (s *FileCheckpointStore) Save(_ context.Context, cp Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.path)
+}
@@ -0,0 +1,43 @@
+package export_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/connectfit-team/go-portone/export"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := export.NewFileCheckpointStore(filepath.Join(dir, "checkpoint.json"))
+
+	_, ok, err := store.Load(ctx)
+	if err != nil || ok {
+		t.Fatalf("expected no checkpoint, got ok=%t err=%v", ok, err)
+	}
+
+	for _, cp := range []export.Checkpoint{
+		{From: 1704067200, To: 1704153600, Status: "all", NextPage: 2, Payments: 100},
+		{From: 1704067200, To: 1704153600, Status: "all", NextPage: 3, Payments: 150, Done: true},
+	} {
+		if err := store.Save(ctx, cp); err != nil {
+			t.Fatal(err)
+		}
+
+		got, ok, err := store.Load(ctx)
+		if err != nil || !ok {
+			t.Fatalf("expected a checkpoint, got ok=%t err=%v", ok, err)
+		}
+		if diff := cmp.Diff(cp, got); diff != "" {
+			t.Errorf("unexpected checkpoint (-want +got):\n%s", diff)
+		}
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.tmp"))
+	if err != nil || len(matches) != 0 {
+		t.Errorf("unexpected temporary files: %v", matches)
+	}
+}
@@ -0,0 +1,97 @@
+package export
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+// cancelHistoryPrefix prefixes the columns of the cancellations, which are flattened to one row per cancellation.
+const cancelHistoryPrefix = "cancel_history."
+
+// PIIColumns are the columns holding personal information about the buyer, masked by WithPIIMasking.
+var PIIColumns = []string{
+	"buyer_name",
+	"buyer_email",
+	"buyer_tel",
+	"buyer_addr",
+	"buyer_postcode",
+	"card_number",
+	"vbank_num",
+	"vbank_holder",
+	"customer_uid",
+}
+
+// column is a field of portone.Payment or, when cancellation is set, of portone.CancelHistory.
+type column struct {
+	name         string
+	index        []int
+	cancellation bool
+}
+
+func (c column) value(p *portone.Payment, ch *portone.CancelHistory) any {
+	v := reflect.ValueOf(p).Elem()
+	if c.cancellation {
+		if ch == nil {
+			return nil
+		}
+		v = reflect.ValueOf(ch).Elem()
+	}
+
+	return v.FieldByIndex(c.index).Interface()
+}
+
+// allColumns are the columns of every field of the payment model, in the order of the declaration of the fields.
+var allColumns = append(
+	structColumns(reflect.TypeOf(portone.Payment{}), "", nil, false),
+	structColumns(reflect.TypeOf(portone.CancelHistory{}), cancelHistoryPrefix, nil, true)...,
+)
+
+// Columns returns the names of the columns an export can select, in their default order.
+func Columns() []string {
+	names := make([]string, 0, len(allColumns))
+	for _, c := range allColumns {
+		names = append(names, c.name)
+	}
+
+	return names
+}
+
+// structColumns returns the columns of the fields of t, named after their JSON keys. Embedded structs are inlined and
+// the cancellations are skipped since they are exported as their own columns.
+func structColumns(t reflect.Type, prefix string, index []int, cancellation bool) []column {
+	var columns []column
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			columns = append(columns, structColumns(f.Type, prefix, fieldIndex, cancellation)...)
+			continue
+		}
+		if f.Type == reflect.TypeOf([]portone.CancelHistory(nil)) {
+			continue
+		}
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		columns = append(columns, column{name: prefix + name, index: fieldIndex, cancellation: cancellation})
+	}
+
+	return columns
+}
+
+// Mask hides the middle of a value, keeping its first and last quarters.
+func Mask(s string) string {
+	runes := []rune(s)
+	keep := len(runes) / 4
+	for i := keep; i < len(runes)-keep; i++ {
+		runes[i] = '*'
+	}
+
+	return string(runes)
+}
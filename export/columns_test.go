@@ -0,0 +1,50 @@
+package export_test
+
+import (
+	"testing"
+
+	"github.com/connectfit-team/go-portone/export"
+)
+
+func TestColumns(t *testing.T) {
+	columns := make(map[string]bool)
+	for _, c := range export.Columns() {
+		if columns[c] {
+			t.Errorf("duplicated column %s", c)
+		}
+		columns[c] = true
+	}
+
+	for _, c := range []string{"imp_uid", "vbank_num", "cancel_receipt_urls", "customer_uid_usage", "cancel_history.pg_tid", "cancel_history.receipt_url"} {
+		if !columns[c] {
+			t.Errorf("missing column %s", c)
+		}
+	}
+	if columns["cancel_history"] {
+		t.Errorf("expected the cancellations to be flattened")
+	}
+
+	for _, c := range export.PIIColumns {
+		if !columns[c] {
+			t.Errorf("unknown PII column %s", c)
+		}
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "", want: ""},
+		{in: "홍길동", want: "***"},
+		{in: "01012345678", want: "01*******78"},
+		{in: "test@example.com", want: "test********.com"},
+	}
+
+	for _, tt := range tests {
+		if got := export.Mask(tt.in); got != tt.want {
+			t.Errorf("Mask(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+// Package export dumps PortOne payments to CSV or JSONL for analytics.
+//
+// Every field of portone.Payment is exported as a column named after its JSON key. The cancellations are flattened
+// into the cancel_history.* columns, with one row per cancellation:
+//
+//	exporter := export.NewExporter(client,
+//		export.WithPIIMasking(),
+//		export.WithCheckpointStore(export.NewFileCheckpointStore("payments.checkpoint")),
+//	)
+//	err := exporter.Export(ctx, f, export.FormatCSV, from, to)
+//
+// With a checkpoint store, an interrupted export resumes after the last page saved in the checkpoint. Each page is
+// written to the output in a single call, but the process may stop between that write and the save of the checkpoint,
+// or in the middle of the write: truncate the output to the Offset of the saved checkpoint, then reopen it for
+// appending before resuming.
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+// PaymentLister lists PortOne payments. It is satisfied by *portone.Client.
+type PaymentLister interface {
+	GetPaymentsByStatus(ctx context.Context, req portone.GetPaymentsByStatusRequest) (portone.GetPaymentsByStatusResponse, error)
+}
+
+// Format is the format of an export.
+type Format string
+
+const (
+	// FormatCSV writes a header followed by a row per payment.
+	FormatCSV Format = "csv"
+	// FormatJSONL writes a JSON object per payment and line, whose keys are the columns.
+	FormatJSONL Format = "jsonl"
+)
+
+var (
+	// ErrUnknownColumn is reported when a selected or masked column does not exist. See Columns.
+	ErrUnknownColumn = errors.New("export: unknown column")
+	// ErrUnknownFormat is reported when the format is neither FormatCSV nor FormatJSONL.
+	ErrUnknownFormat = errors.New("export: unknown format")
+	// ErrCheckpointMismatch is reported when the saved checkpoint belongs to an export of another period or status.
+	ErrCheckpointMismatch = errors.New("export: checkpoint of another export")
+)
+
+// pageSize is the maximum number of payments PortOne returns per page.
+const pageSize = 100
+
+type exporterConfig struct {
+	columns     []string
+	masked      map[string]struct{}
+	status      string
+	checkpoints CheckpointStore
+}
+
+// Option configures an Exporter.
+type Option func(*exporterConfig)
+
+// WithColumns only exports the given columns, in the given order. See Columns for the available ones.
+func WithColumns(names ...string) Option {
+	return func(c *exporterConfig) {
+		c.columns = names
+	}
+}
+
+// WithMaskedColumns masks the values of the given columns with Mask.
+func WithMaskedColumns(names ...string) Option {
+	return func(c *exporterConfig) {
+		for _, name := range names {
+			c.masked[name] = struct{}{}
+		}
+	}
+}
+
+// WithPIIMasking masks the PIIColumns.
+func WithPIIMasking() Option {
+	return WithMaskedColumns(PIIColumns...)
+}
+
+// WithStatus only exports the payments in a status: ready, paid, cancelled or failed.
+func WithStatus(status string) Option {
+	return func(c *exporterConfig) {
+		c.status = status
+	}
+}
+
+// WithCheckpointStore saves the progress of the export after every page, and resumes from it.
+func WithCheckpointStore(store CheckpointStore) Option {
+	return func(c *exporterConfig) {
+		c.checkpoints = store
+	}
+}
+
+// Exporter writes the payments of a period.
+type Exporter struct {
+	payments PaymentLister
+	cfg      exporterConfig
+}
+
+// NewExporter returns an Exporter listing the payments with payments.
+func NewExporter(payments PaymentLister, opts ...Option) *Exporter {
+	cfg := exporterConfig{
+		masked: make(map[string]struct{}),
+		status: portone.PaymentStatusAll,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return &Exporter{
+		payments: payments,
+		cfg:      cfg,
+	}
+}
+
+// Export writes the payments whose status changed between from and to.
+//
+// The payments are listed from the oldest, so that the pages stay stable while resuming an export of a past period.
+func (e *Exporter) Export(ctx context.Context, w io.Writer, format Format, from, to time.Time) error {
+	columns, err := e.columns()
+	if err != nil {
+		return err
+	}
+
+	rw, err := newRowWriter(w, format, columns)
+	if err != nil {
+		return err
+	}
+
+	cp := Checkpoint{From: from.Unix(), To: to.Unix(), Status: e.cfg.status, NextPage: 1}
+	resumed := false
+	if e.cfg.checkpoints != nil {
+		saved, ok, err := e.cfg.checkpoints.Load(ctx)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if saved.From != cp.From || saved.To != cp.To || saved.Status != cp.Status {
+				return ErrCheckpointMismatch
+			}
+			if saved.Done {
+				return nil
+			}
+			cp, resumed = saved, true
+		}
+	}
+
+	if !resumed {
+		err = rw.writeHeader()
+		if err != nil {
+			return err
+		}
+	}
+
+	expand := false
+	for _, c := range columns {
+		expand = expand || c.cancellation
+	}
+
+	for {
+		resp, err := e.payments.GetPaymentsByStatus(ctx, portone.GetPaymentsByStatusRequest{
+			Status:  cp.Status,
+			Page:    cp.NextPage,
+			Limit:   pageSize,
+			From:    cp.From,
+			To:      cp.To,
+			Sorting: "started",
+		})
+		if err != nil {
+			return err
+		}
+		if err := resp.Err(); err != nil {
+			return err
+		}
+
+		for i := range resp.Response.List {
+			err = e.writePayment(rw, columns, &resp.Response.List[i], expand)
+			if err != nil {
+				return err
+			}
+		}
+		n, err := rw.flush()
+		if err != nil {
+			return err
+		}
+
+		cp.Offset += int64(n)
+		cp.Payments += len(resp.Response.List)
+		if resp.HasNextPage() {
+			cp.NextPage = resp.Response.Next
+		} else {
+			cp.Done = true
+		}
+
+		if e.cfg.checkpoints != nil {
+			err = e.cfg.checkpoints.Save(ctx, cp)
+			if err != nil {
+				return err
+			}
+		}
+
+		if cp.Done {
+			return nil
+		}
+	}
+}
+
+func (e *Exporter) columns() ([]column, error) {
+	byName := make(map[string]column, len(allColumns))
+	for _, c := range allColumns {
+		byName[c.name] = c
+	}
+
+	for name := range e.cfg.masked {
+		if _, ok := byName[name]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownColumn, name)
+		}
+	}
+
+	if len(e.cfg.columns) == 0 {
+		return allColumns, nil
+	}
+
+	columns := make([]column, 0, len(e.cfg.columns))
+	for _, name := range e.cfg.columns {
+		c, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownColumn, name)
+		}
+		columns = append(columns, c)
+	}
+
+	return columns, nil
+}
+
+// writePayment writes a row per cancellation of the payment when expand is set, a single row otherwise.
+func (e *Exporter) writePayment(rw rowWriter, columns []column, p *portone.Payment, expand bool) error {
+	if !expand || len(p.CancelHistory) == 0 {
+		return rw.writeRow(e.row(columns, p, nil))
+	}
+
+	for i := range p.CancelHistory {
+		err := rw.writeRow(e.row(columns, p, &p.CancelHistory[i]))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) row(columns []column, p *portone.Payment, ch *portone.CancelHistory) []any {
+	row := make([]any, 0, len(columns))
+	for _, c := range columns {
+		v := c.value(p, ch)
+		if _, ok := e.cfg.masked[c.name]; ok {
+			v = Mask(formatValue(v))
+		}
+		row = append(row, v)
+	}
+
+	return row
+}
+
+// formatValue formats a value of a column as text. Lists are joined with semicolons.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ";")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// rowWriter buffers the rows of a page until flush writes them to the output in a single call.
+type rowWriter interface {
+	writeHeader() error
+	writeRow(row []any) error
+	// flush returns the number of bytes written to the output.
+	flush() (int, error)
+}
+
+func newRowWriter(w io.Writer, format Format, columns []column) (rowWriter, error) {
+	switch format {
+	case FormatCSV:
+		cw := &csvWriter{w: w, columns: columns}
+		cw.csv = csv.NewWriter(&cw.buf)
+		return cw, nil
+	case FormatJSONL:
+		return &jsonlWriter{w: w, columns: columns}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+type csvWriter struct {
+	w       io.Writer
+	columns []column
+	csv     *csv.Writer
+	buf     bytes.Buffer
+}
+
+func (cw *csvWriter) writeHeader() error {
+	header := make([]string, 0, len(cw.columns))
+	for _, c := range cw.columns {
+		header = append(header, c.name)
+	}
+
+	return cw.csv.Write(header)
+}
+
+func (cw *csvWriter) writeRow(row []any) error {
+	record := make([]string, 0, len(row))
+	for _, v := range row {
+		record = append(record, formatValue(v))
+	}
+
+	return cw.csv.Write(record)
+}
+
+func (cw *csvWriter) flush() (int, error) {
+	cw.csv.Flush()
+	if err := cw.csv.Error(); err != nil {
+		return 0, err
+	}
+
+	n, err := cw.w.Write(cw.buf.Bytes())
+	cw.buf.Reset()
+	return n, err
+}
+
+type jsonlWriter struct {
+	w       io.Writer
+	columns []column
+	buf     bytes.Buffer
+}
+
+func (jw *jsonlWriter) writeHeader() error {
+	return nil
+}
+
+// writeRow buffers the row as a JSON object keeping the order of the columns.
+func (jw *jsonlWriter) writeRow(row []any) error {
+	jw.buf.WriteByte('{')
+	for i, v := range row {
+		if i > 0 {
+			jw.buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(jw.columns[i].name)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		jw.buf.Write(key)
+		jw.buf.WriteByte(':')
+		jw.buf.Write(value)
+	}
+	jw.buf.WriteString("}\n")
+
+	return nil
+}
+
+func (jw *jsonlWriter) flush() (int, error) {
+	n, err := jw.w.Write(jw.buf.Bytes())
+	jw.buf.Reset()
+	return n, err
+}
@@ -0,0 +1,332 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/connectfit-team/go-portone/export"
+	"github.com/connectfit-team/go-portone/portonemock"
+	"github.com/google/go-cmp/cmp"
+)
+
+var _ export.PaymentLister = (*portone.Client)(nil)
+
+var (
+	testFrom = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testTo   = testFrom.AddDate(0, 0, 1)
+)
+
+var testPayments = []portone.Payment{
+	{
+		ImpUID:      "imp_1",
+		MerchantUID: "order_1",
+		Amount:      1000,
+		Status:      "paid",
+		BuyerName:   "홍길동",
+		BuyerTel:    "01012345678",
+	},
+	{
+		ImpUID:       "imp_2",
+		MerchantUID:  "order_2",
+		Amount:       2000,
+		CancelAmount: 2000,
+		Status:       "cancelled",
+		BuyerTel:     "01087654321",
+		CancelHistory: []portone.CancelHistory{
+			{PgTid: "pg_cancel_1", Amount: 500, CancelledAt: 1704067200, Reason: "partial"},
+			{PgTid: "pg_cancel_2", Amount: 1500, CancelledAt: 1704070800, Reason: "rest"},
+		},
+	},
+	{
+		ImpUID:      "imp_3",
+		MerchantUID: "order_3",
+		Amount:      3000,
+		Status:      "failed",
+	},
+}
+
+// pagedPayments returns a mock listing the test payments one by one. Fetching the page failAt fails.
+func pagedPayments(t *testing.T, failAt int) *portonemock.Client {
+	return &portonemock.Client{
+		GetPaymentsByStatusFunc: func(_ context.Context, req portone.GetPaymentsByStatusRequest) (portone.GetPaymentsByStatusResponse, error) {
+			if req.From != testFrom.Unix() || req.To != testTo.Unix() || req.Sorting != "started" {
+				t.Errorf("unexpected request: %+v", req)
+			}
+			if req.Page == failAt {
+				return portone.GetPaymentsByStatusResponse{}, errors.New("test error")
+			}
+
+			var resp portone.GetPaymentsByStatusResponse
+			resp.Response.Total = len(testPayments)
+			resp.Response.List = testPayments[req.Page-1 : req.Page]
+			if req.Page < len(testPayments) {
+				resp.Response.Next = req.Page + 1
+			}
+			return resp, nil
+		},
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	exporter := export.NewExporter(pagedPayments(t, 0),
+		export.WithColumns("imp_uid", "status", "amount", "buyer_tel", "cancel_history.amount", "cancel_history.reason"),
+		export.WithPIIMasking(),
+	)
+
+	var buf bytes.Buffer
+	err := exporter.Export(context.Background(), &buf, export.FormatCSV, testFrom, testTo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "imp_uid,status,amount,buyer_tel,cancel_history.amount,cancel_history.reason\n" +
+		"imp_1,paid,1000,01*******78,,\n" +
+		"imp_2,cancelled,2000,01*******21,500,partial\n" +
+		"imp_2,cancelled,2000,01*******21,1500,rest\n" +
+		"imp_3,failed,3000,,,\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("unexpected CSV (-want +got):\n%s", diff)
+	}
+}
+
+func TestExportCSVAllColumns(t *testing.T) {
+	exporter := export.NewExporter(pagedPayments(t, 0))
+
+	var buf bytes.Buffer
+	err := exporter.Export(context.Background(), &buf, export.FormatCSV, testFrom, testTo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected a header and 4 rows, got %d lines", len(lines))
+	}
+	if lines[0] != strings.Join(export.Columns(), ",") {
+		t.Errorf("unexpected header: %s", lines[0])
+	}
+	if !strings.Contains(lines[1], "홍길동") {
+		t.Errorf("expected the buyer name not to be masked: %s", lines[1])
+	}
+}
+
+func TestExportJSONL(t *testing.T) {
+	exporter := export.NewExporter(pagedPayments(t, 0),
+		export.WithColumns("imp_uid", "amount", "buyer_name", "cancel_receipt_urls"),
+		export.WithMaskedColumns("buyer_name"),
+		export.WithStatus("paid"),
+	)
+
+	var buf bytes.Buffer
+	err := exporter.Export(context.Background(), &buf, export.FormatJSONL, testFrom, testTo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The cancellations are not selected, so there is a single row per payment.
+	want := `{"imp_uid":"imp_1","amount":1000,"buyer_name":"***","cancel_receipt_urls":null}` + "\n" +
+		`{"imp_uid":"imp_2","amount":2000,"buyer_name":"","cancel_receipt_urls":null}` + "\n" +
+		`{"imp_uid":"imp_3","amount":3000,"buyer_name":"","cancel_receipt_urls":null}` + "\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("unexpected JSONL (-want +got):\n%s", diff)
+	}
+}
+
+// crashingWriter appends to buf, but only writes half of the bytes of the write numbered crashAt before failing.
+type crashingWriter struct {
+	buf     *bytes.Buffer
+	writes  int
+	crashAt int
+}
+
+func (w *crashingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.writes == w.crashAt {
+		n, _ := w.buf.Write(p[:len(p)/2])
+		return n, errors.New("test crash")
+	}
+
+	return w.buf.Write(p)
+}
+
+// failingStore fails to save the checkpoint numbered failAt.
+type failingStore struct {
+	export.CheckpointStore
+	saves  int
+	failAt int
+}
+
+func (s *failingStore) Save(ctx context.Context, cp export.Checkpoint) error {
+	s.saves++
+	if s.saves == s.failAt {
+		return errors.New("test crash")
+	}
+
+	return s.CheckpointStore.Save(ctx, cp)
+}
+
+func TestExportResume(t *testing.T) {
+	columns := export.WithColumns("imp_uid", "cancel_history.amount")
+	want := "imp_uid,cancel_history.amount\nimp_1,\nimp_2,500\nimp_2,1500\nimp_3,\n"
+	firstPage := "imp_uid,cancel_history.amount\nimp_1,\n"
+
+	tests := []struct {
+		name    string
+		crashAt int
+		failAt  int
+	}{
+		{name: "crash in the middle of a page", crashAt: 2},
+		{name: "crash before saving the checkpoint", failAt: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := export.NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+
+			var buf bytes.Buffer
+			w := &crashingWriter{buf: &buf, crashAt: tt.crashAt}
+			exporter := export.NewExporter(pagedPayments(t, 0), columns, export.WithCheckpointStore(&failingStore{CheckpointStore: store, failAt: tt.failAt}))
+			err := exporter.Export(ctx, w, export.FormatCSV, testFrom, testTo)
+			if err == nil {
+				t.Fatal("expected the export to be interrupted")
+			}
+
+			cp, ok, err := store.Load(ctx)
+			if err != nil || !ok {
+				t.Fatalf("expected a checkpoint: %v", err)
+			}
+			wantCP := export.Checkpoint{
+				From:     testFrom.Unix(),
+				To:       testTo.Unix(),
+				Status:   portone.PaymentStatusAll,
+				NextPage: 2,
+				Payments: 1,
+				Offset:   int64(len(firstPage)),
+			}
+			if diff := cmp.Diff(wantCP, cp); diff != "" {
+				t.Errorf("unexpected checkpoint (-want +got):\n%s", diff)
+			}
+
+			buf.Truncate(int(cp.Offset))
+			err = export.NewExporter(pagedPayments(t, 0), columns, export.WithCheckpointStore(store)).Export(ctx, &buf, export.FormatCSV, testFrom, testTo)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(want, buf.String()); diff != "" {
+				t.Errorf("unexpected CSV after resuming (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExportResumeDone(t *testing.T) {
+	ctx := context.Background()
+	store := export.NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	opts := []export.Option{export.WithColumns("imp_uid"), export.WithCheckpointStore(store)}
+
+	var buf bytes.Buffer
+	err := export.NewExporter(pagedPayments(t, 0), opts...).Export(ctx, &buf, export.FormatCSV, testFrom, testTo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A finished export is not written again.
+	client := pagedPayments(t, 0)
+	err = export.NewExporter(client, opts...).Export(ctx, &buf, export.FormatCSV, testFrom, testTo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls := client.Calls(); len(calls) != 0 {
+		t.Errorf("unexpected calls: %+v", calls)
+	}
+	if want := "imp_uid\nimp_1\nimp_2\nimp_3\n"; buf.String() != want {
+		t.Errorf("unexpected CSV: %q", buf.String())
+	}
+
+	err = export.NewExporter(client, opts...).Export(ctx, &buf, export.FormatCSV, testFrom, testTo.AddDate(0, 0, 1))
+	if !errors.Is(err, export.ErrCheckpointMismatch) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestExportWritesPages(t *testing.T) {
+	// A page larger than the buffer of a csv.Writer.
+	page := make([]portone.Payment, 100)
+	for i := range page {
+		page[i] = portone.Payment{ImpUID: fmt.Sprintf("imp_%d", i), Name: strings.Repeat("test_name", 10), Status: "paid"}
+	}
+	client := &portonemock.Client{
+		GetPaymentsByStatusFunc: func(context.Context, portone.GetPaymentsByStatusRequest) (portone.GetPaymentsByStatusResponse, error) {
+			var resp portone.GetPaymentsByStatusResponse
+			resp.Response.List = page
+			return resp, nil
+		},
+	}
+
+	for _, format := range []export.Format{export.FormatCSV, export.FormatJSONL} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			w := &crashingWriter{buf: &buf}
+
+			err := export.NewExporter(client).Export(context.Background(), w, format, testFrom, testTo)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if w.writes != 1 {
+				t.Errorf("expected a single write of %d bytes, got %d writes", buf.Len(), w.writes)
+			}
+		})
+	}
+}
+
+func TestExportErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []export.Option
+		format export.Format
+		want   error
+	}{
+		{
+			name:   "unknown column",
+			opts:   []export.Option{export.WithColumns("imp_uid", "unknown")},
+			format: export.FormatCSV,
+			want:   export.ErrUnknownColumn,
+		},
+		{
+			name:   "unknown masked column",
+			opts:   []export.Option{export.WithMaskedColumns("unknown")},
+			format: export.FormatCSV,
+			want:   export.ErrUnknownColumn,
+		},
+		{
+			name:   "unknown format",
+			format: export.Format("xlsx"),
+			want:   export.ErrUnknownFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := pagedPayments(t, 0)
+
+			var buf bytes.Buffer
+			err := export.NewExporter(client, tt.opts...).Export(context.Background(), &buf, tt.format, testFrom, testTo)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if buf.Len() != 0 || len(client.Calls()) != 0 {
+				t.Errorf("expected nothing to be exported")
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Package gateway provides a version agnostic view of the PortOne payment APIs, so code dealing with
+// payments works the same whether the merchant is on the V1 (iamport) or the V2 API.
+//
+// Both APIs are mapped into a normalized Payment which keeps the original representation in Raw:
+//
+//	var g gateway.PaymentGateway = gateway.NewV1(v1Client)
+//	payment, err := g.VerifyAmount(ctx, paymentID, order.Amount)
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/connectfit-team/go-portone"
+	v2 "github.com/connectfit-team/go-portone/v2"
+)
+
+// PaymentGateway is implemented by the V1 and V2 adapters.
+//
+// Payment IDs are the identifiers each API looks payments up with: the imp_uid for V1 and the payment ID for V2.
+// Use Payment.ID to get the one of a payment.
+type PaymentGateway interface {
+	// GetPayment returns a payment.
+	GetPayment(ctx context.Context, paymentID string) (Payment, error)
+	// CancelPayment cancels a payment fully or partially and returns it updated.
+	CancelPayment(ctx context.Context, req CancelRequest) (Payment, error)
+	// VerifyAmount returns a payment after checking it has been paid the expected amount.
+	// It reports ErrNotPaid or an *AmountMismatchError otherwise, along with the payment.
+	VerifyAmount(ctx context.Context, paymentID string, expected int64) (Payment, error)
+	// PayWithBillingKey charges a billing key and returns the resulting payment.
+	// A declined charge is returned as a payment with StatusFailed rather than as an error.
+	PayWithBillingKey(ctx context.Context, req BillingKeyPaymentRequest) (Payment, error)
+}
+
+// Version is the PortOne API version a payment comes from.
+type Version string
+
+const (
+	V1 Version = "v1"
+	V2 Version = "v2"
+)
+
+// Status is the normalized status of a payment.
+type Status string
+
+const (
+	StatusReady                Status = "READY"
+	StatusVirtualAccountIssued Status = "VIRTUAL_ACCOUNT_ISSUED"
+	StatusPaid                 Status = "PAID"
+	StatusPartialCancelled     Status = "PARTIAL_CANCELLED"
+	StatusCancelled            Status = "CANCELLED"
+	StatusFailed               Status = "FAILED"
+	// StatusUnknown is a status the adapters do not know about. Inspect Raw to handle it.
+	StatusUnknown Status = "UNKNOWN"
+)
+
+// Cancellation is a normalized full or partial cancellation of a payment.
+type Cancellation struct {
+	Amount      int64
+	Reason      string
+	PgTxID      string
+	ReceiptURL  string
+	CancelledAt time.Time
+}
+
+// Payment is the normalized representation of a payment.
+type Payment struct {
+	Version Version
+	// ID is the identifier to pass back to the gateway: the imp_uid for V1 and the payment ID for V2.
+	ID string
+	// OrderID is the identifier chosen by the merchant: the merchant_uid for V1 and the payment ID for V2.
+	OrderID         string
+	Status          Status
+	OrderName       string
+	Currency        string
+	Amount          int64
+	CancelledAmount int64
+	PgProvider      string
+	PgTxID          string
+	ReceiptURL      string
+	FailureReason   string
+	// PaidAt, FailedAt and CancelledAt are zero when the payment did not go through the matching state.
+	PaidAt        time.Time
+	FailedAt      time.Time
+	CancelledAt   time.Time
+	Cancellations []Cancellation
+
+	// Raw is the payment as returned by the API: a portone.Payment for V1 and a v2.Payment for V2.
+	// Prefer the V1 and V2 methods to access it.
+	Raw any
+}
+
+// V1 returns the V1 representation of the payment, if it comes from the V1 API.
+func (p Payment) V1() (portone.Payment, bool) {
+	raw, ok := p.Raw.(portone.Payment)
+	return raw, ok
+}
+
+// V2 returns the V2 representation of the payment, if it comes from the V2 API.
+func (p Payment) V2() (v2.Payment, bool) {
+	raw, ok := p.Raw.(v2.Payment)
+	return raw, ok
+}
+
+// CancellableAmount returns the amount which can still be cancelled.
+func (p Payment) CancellableAmount() int64 {
+	return p.Amount - p.CancelledAmount
+}
+
+// CancelRequest represents a full or partial cancellation.
+type CancelRequest struct {
+	PaymentID string
+	// Amount is the amount to cancel, which must be positive. The whole payment is cancelled when nil.
+	Amount        *int64
+	TaxFreeAmount *int64
+	Reason        string
+	// CurrentCancellableAmount makes the cancellation fail if the cancellable amount of the payment differs,
+	// protecting against cancellations based on stale data. See Payment.CancellableAmount.
+	CurrentCancellableAmount *int64
+}
+
+// BillingKeyPaymentRequest represents a charge of a billing key.
+type BillingKeyPaymentRequest struct {
+	// OrderID identifies the new payment: the merchant_uid for V1 and the payment ID for V2.
+	OrderID string
+	// BillingKey is the customer_uid for V1 and the billing key for V2.
+	BillingKey    string
+	OrderName     string
+	Amount        int64
+	TaxFreeAmount *int64
+	// Currency defaults to KRW.
+	Currency      string
+	CustomerName  string
+	CustomerEmail string
+	CustomerPhone string
+}
+
+// ErrInvalidCancelAmount is reported by CancelPayment when the amount to cancel is not positive.
+var ErrInvalidCancelAmount = errors.New("gateway: cancel amount must be positive")
+
+// ErrNotPaid is reported by VerifyAmount when the payment is not in the paid status.
+var ErrNotPaid = errors.New("gateway: payment is not paid")
+
+// AmountMismatchError is reported by VerifyAmount when the paid amount differs from the expected one.
+type AmountMismatchError struct {
+	Expected int64
+	Actual   int64
+}
+
+func (e *AmountMismatchError) Error() string {
+	return fmt.Sprintf("gateway: amount mismatch: expected=%d actual=%d", e.Expected, e.Actual)
+}
+
+func verifyAmount(ctx context.Context, g PaymentGateway, paymentID string, expected int64) (Payment, error) {
+	p, err := g.GetPayment(ctx, paymentID)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	if p.Status != StatusPaid {
+		return p, fmt.Errorf("%w: status=%s", ErrNotPaid, p.Status)
+	}
+	if p.Amount != expected {
+		return p, &AmountMismatchError{Expected: expected, Actual: p.Amount}
+	}
+
+	return p, nil
+}
+
+func validateCancelRequest(req CancelRequest) error {
+	if req.Amount != nil && *req.Amount <= 0 {
+		return fmt.Errorf("%w: amount=%d", ErrInvalidCancelAmount, *req.Amount)
+	}
+
+	return nil
+}
+
+func unixTime(sec int) time.Time {
+	if sec <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(sec), 0)
+}
+
+func timeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
@@ -0,0 +1,152 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+// V1Client is the subset of the V1 client used by the V1 adapter. It is satisfied by *portone.Client.
+type V1Client interface {
+	GetPayment(ctx context.Context, paymentID string) (portone.GetPaymentResponse, error)
+	CancelPayment(ctx context.Context, req portone.CancelPaymentRequest) (portone.CancelPaymentResponse, error)
+	PayAgain(ctx context.Context, req portone.PayAgainRequest) (portone.PayAgainResponse, error)
+}
+
+var _ V1Client = (*portone.Client)(nil)
+
+type v1Gateway struct {
+	client V1Client
+}
+
+// NewV1 returns a PaymentGateway over the V1 API.
+// Errors reported in the response body are returned as *portone.Error.
+func NewV1(client V1Client) PaymentGateway {
+	return &v1Gateway{client: client}
+}
+
+func (g *v1Gateway) GetPayment(ctx context.Context, paymentID string) (Payment, error) {
+	resp, err := g.client.GetPayment(ctx, paymentID)
+	if err != nil {
+		return Payment{}, err
+	}
+	if err := resp.Err(); err != nil {
+		return Payment{}, err
+	}
+
+	return FromV1(resp.Response), nil
+}
+
+func (g *v1Gateway) CancelPayment(ctx context.Context, req CancelRequest) (Payment, error) {
+	if err := validateCancelRequest(req); err != nil {
+		return Payment{}, err
+	}
+
+	v1Req := portone.CancelPaymentRequest{
+		ImpUID:   req.PaymentID,
+		Reason:   req.Reason,
+		Checksum: req.CurrentCancellableAmount,
+	}
+	if req.Amount != nil {
+		v1Req.Amount = *req.Amount
+	}
+	if req.TaxFreeAmount != nil {
+		v1Req.TaxFree = *req.TaxFreeAmount
+	}
+
+	resp, err := g.client.CancelPayment(ctx, v1Req)
+	if err != nil {
+		return Payment{}, err
+	}
+	if err := resp.Err(); err != nil {
+		return Payment{}, err
+	}
+
+	return FromV1(resp.Response), nil
+}
+
+func (g *v1Gateway) VerifyAmount(ctx context.Context, paymentID string, expected int64) (Payment, error) {
+	return verifyAmount(ctx, g, paymentID, expected)
+}
+
+func (g *v1Gateway) PayWithBillingKey(ctx context.Context, req BillingKeyPaymentRequest) (Payment, error) {
+	v1Req := portone.PayAgainRequest{
+		CustomerUID: req.BillingKey,
+		MerchantUID: req.OrderID,
+		Amount:      req.Amount,
+		Currency:    req.Currency,
+		Name:        req.OrderName,
+		BuyerName:   req.CustomerName,
+		BuyerEmail:  req.CustomerEmail,
+		BuyerTel:    req.CustomerPhone,
+	}
+	if req.TaxFreeAmount != nil {
+		v1Req.TaxFree = *req.TaxFreeAmount
+	}
+
+	resp, err := g.client.PayAgain(ctx, v1Req)
+	if err != nil {
+		return Payment{}, err
+	}
+	if err := resp.Err(); err != nil {
+		return Payment{}, err
+	}
+
+	return FromV1(resp.Response), nil
+}
+
+// FromV1 normalizes a V1 payment.
+func FromV1(p portone.Payment) Payment {
+	np := Payment{
+		Version:         V1,
+		ID:              p.ImpUID,
+		OrderID:         p.MerchantUID,
+		Status:          v1Status(p),
+		OrderName:       p.Name,
+		Currency:        p.Currency,
+		Amount:          int64(p.Amount),
+		CancelledAmount: int64(p.CancelAmount),
+		PgProvider:      p.PgProvider,
+		PgTxID:          p.PgTid,
+		ReceiptURL:      p.ReceiptURL,
+		FailureReason:   p.FailReason,
+		PaidAt:          unixTime(p.PaidAt),
+		FailedAt:        unixTime(p.FailedAt),
+		CancelledAt:     unixTime(p.CancelledAt),
+		Raw:             p,
+	}
+
+	for _, c := range p.CancelHistory {
+		np.Cancellations = append(np.Cancellations, Cancellation{
+			Amount:      int64(c.Amount),
+			Reason:      c.Reason,
+			PgTxID:      c.PgTid,
+			ReceiptURL:  c.ReceiptURL,
+			CancelledAt: unixTime(c.CancelledAt),
+		})
+	}
+
+	return np
+}
+
+// v1Status maps the V1 status, which does not distinguish partial cancellations nor issued virtual accounts.
+func v1Status(p portone.Payment) Status {
+	switch p.Status {
+	case "ready":
+		if p.VbankNum != "" {
+			return StatusVirtualAccountIssued
+		}
+		return StatusReady
+	case "paid":
+		if p.CancelAmount > 0 {
+			return StatusPartialCancelled
+		}
+		return StatusPaid
+	case "cancelled":
+		return StatusCancelled
+	case "failed":
+		return StatusFailed
+	default:
+		return StatusUnknown
+	}
+}
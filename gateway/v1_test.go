@@ -0,0 +1,257 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/connectfit-team/go-portone/gateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type fakeV1Client struct {
+	payments  map[string]portone.Payment
+	cancelled []portone.CancelPaymentRequest
+	// declineReason makes PayAgain report a payment declined by the PG.
+	declineReason string
+}
+
+func (f *fakeV1Client) GetPayment(_ context.Context, impUID string) (portone.GetPaymentResponse, error) {
+	p, ok := f.payments[impUID]
+	if !ok {
+		return portone.GetPaymentResponse{CommonResponse: portone.CommonResponse{Code: -1, Message: "not found"}}, nil
+	}
+
+	return portone.GetPaymentResponse{Response: p}, nil
+}
+
+func (f *fakeV1Client) CancelPayment(_ context.Context, req portone.CancelPaymentRequest) (portone.CancelPaymentResponse, error) {
+	f.cancelled = append(f.cancelled, req)
+
+	p := f.payments[req.ImpUID]
+	p.CancelAmount += int(req.Amount)
+	p.CancelHistory = append(p.CancelHistory, portone.CancelHistory{Amount: int(req.Amount), Reason: req.Reason, CancelledAt: 1700000100})
+	f.payments[req.ImpUID] = p
+
+	return portone.CancelPaymentResponse{Response: p}, nil
+}
+
+func (f *fakeV1Client) PayAgain(_ context.Context, req portone.PayAgainRequest) (portone.PayAgainResponse, error) {
+	p := portone.Payment{
+		ImpUID:      "imp_again",
+		MerchantUID: req.MerchantUID,
+		CustomerUID: req.CustomerUID,
+		Amount:      int(req.Amount),
+		Status:      "paid",
+		PaidAt:      1700000000,
+	}
+	if f.declineReason != "" {
+		p.Status = "failed"
+		p.PaidAt = 0
+		p.FailedAt = 1700000000
+		p.FailReason = f.declineReason
+	}
+
+	return portone.PayAgainResponse{Response: p}, nil
+}
+
+func TestV1GetPayment(t *testing.T) {
+	raw := portone.Payment{
+		ImpUID:      "imp_1",
+		MerchantUID: "order_1",
+		Name:        "test_order_name",
+		Currency:    "KRW",
+		Amount:      1000,
+		PgProvider:  "html5_inicis",
+		PgTid:       "test_pg_tid",
+		Status:      "paid",
+		PaidAt:      1700000000,
+	}
+	g := gateway.NewV1(&fakeV1Client{payments: map[string]portone.Payment{"imp_1": raw}})
+
+	got, err := g.GetPayment(context.Background(), "imp_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := gateway.Payment{
+		Version:    gateway.V1,
+		ID:         "imp_1",
+		OrderID:    "order_1",
+		Status:     gateway.StatusPaid,
+		OrderName:  "test_order_name",
+		Currency:   "KRW",
+		Amount:     1000,
+		PgProvider: "html5_inicis",
+		PgTxID:     "test_pg_tid",
+		PaidAt:     time.Unix(1700000000, 0),
+		Raw:        raw,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected payment (-want +got):\n%s", diff)
+	}
+
+	if v1, ok := got.V1(); !ok || v1.ImpUID != "imp_1" {
+		t.Errorf("unexpected raw payment: %+v", got.Raw)
+	}
+	if _, ok := got.V2(); ok {
+		t.Errorf("expected no V2 payment")
+	}
+}
+
+func TestV1GetPaymentError(t *testing.T) {
+	g := gateway.NewV1(&fakeV1Client{})
+
+	_, err := g.GetPayment(context.Background(), "imp_unknown")
+
+	var portoneErr *portone.Error
+	if !errors.As(err, &portoneErr) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestV1CancelPayment(t *testing.T) {
+	client := &fakeV1Client{payments: map[string]portone.Payment{
+		"imp_1": {ImpUID: "imp_1", Amount: 1000, Status: "paid"},
+	}}
+	g := gateway.NewV1(client)
+
+	amount := int64(300)
+	cancellable := int64(1000)
+	got, err := g.CancelPayment(context.Background(), gateway.CancelRequest{
+		PaymentID:                "imp_1",
+		Amount:                   &amount,
+		Reason:                   "test_reason",
+		CurrentCancellableAmount: &cancellable,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantReq := portone.CancelPaymentRequest{ImpUID: "imp_1", Amount: 300, Reason: "test_reason", Checksum: &cancellable}
+	if diff := cmp.Diff([]portone.CancelPaymentRequest{wantReq}, client.cancelled); diff != "" {
+		t.Errorf("unexpected cancel request (-want +got):\n%s", diff)
+	}
+
+	if got.Status != gateway.StatusPartialCancelled || got.CancellableAmount() != 700 {
+		t.Errorf("unexpected payment: %+v", got)
+	}
+
+	wantCancellations := []gateway.Cancellation{{Amount: 300, Reason: "test_reason", CancelledAt: time.Unix(1700000100, 0)}}
+	if diff := cmp.Diff(wantCancellations, got.Cancellations); diff != "" {
+		t.Errorf("unexpected cancellations (-want +got):\n%s", diff)
+	}
+}
+
+func TestV1VerifyAmount(t *testing.T) {
+	g := gateway.NewV1(&fakeV1Client{payments: map[string]portone.Payment{
+		"imp_paid":  {ImpUID: "imp_paid", Amount: 1000, Status: "paid"},
+		"imp_vbank": {ImpUID: "imp_vbank", Amount: 1000, Status: "ready", Vbank: portone.Vbank{VbankNum: "123"}},
+	}})
+
+	tests := []struct {
+		name      string
+		paymentID string
+		expected  int64
+		check     func(error) bool
+	}{
+		{
+			name:      "matching",
+			paymentID: "imp_paid",
+			expected:  1000,
+			check:     func(err error) bool { return err == nil },
+		},
+		{
+			name:      "mismatching",
+			paymentID: "imp_paid",
+			expected:  100,
+			check: func(err error) bool {
+				var mismatch *gateway.AmountMismatchError
+				return errors.As(err, &mismatch) && mismatch.Expected == 100 && mismatch.Actual == 1000
+			},
+		},
+		{
+			name:      "not paid",
+			paymentID: "imp_vbank",
+			expected:  1000,
+			check:     func(err error) bool { return errors.Is(err, gateway.ErrNotPaid) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := g.VerifyAmount(context.Background(), tt.paymentID, tt.expected)
+			if !tt.check(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if p.ID != tt.paymentID {
+				t.Errorf("unexpected payment: %+v", p)
+			}
+		})
+	}
+}
+
+func TestV1PayWithBillingKey(t *testing.T) {
+	g := gateway.NewV1(&fakeV1Client{})
+
+	got, err := g.PayWithBillingKey(context.Background(), gateway.BillingKeyPaymentRequest{
+		OrderID:    "order_again",
+		BillingKey: "customer_1",
+		OrderName:  "test_order_name",
+		Amount:     9900,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := gateway.Payment{
+		Version: gateway.V1,
+		ID:      "imp_again",
+		OrderID: "order_again",
+		Status:  gateway.StatusPaid,
+		Amount:  9900,
+		PaidAt:  time.Unix(1700000000, 0),
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(gateway.Payment{}, "Raw")); diff != "" {
+		t.Errorf("unexpected payment (-want +got):\n%s", diff)
+	}
+}
+
+func TestV1CancelPaymentInvalidAmount(t *testing.T) {
+	client := &fakeV1Client{payments: map[string]portone.Payment{
+		"imp_1": {ImpUID: "imp_1", Amount: 1000, Status: "paid"},
+	}}
+	g := gateway.NewV1(client)
+
+	for _, amount := range []int64{0, -100} {
+		_, err := g.CancelPayment(context.Background(), gateway.CancelRequest{PaymentID: "imp_1", Amount: &amount, Reason: "test_reason"})
+		if !errors.Is(err, gateway.ErrInvalidCancelAmount) {
+			t.Errorf("unexpected error for amount %d: %v", amount, err)
+		}
+	}
+
+	if len(client.cancelled) != 0 {
+		t.Errorf("unexpected cancel requests: %+v", client.cancelled)
+	}
+}
+
+func TestV1PayWithBillingKeyDeclined(t *testing.T) {
+	g := gateway.NewV1(&fakeV1Client{declineReason: "한도초과"})
+
+	got, err := g.PayWithBillingKey(context.Background(), gateway.BillingKeyPaymentRequest{
+		OrderID:    "order_again",
+		BillingKey: "customer_1",
+		OrderName:  "test_order_name",
+		Amount:     9900,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Status != gateway.StatusFailed || got.FailureReason != "한도초과" || !got.FailedAt.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("unexpected payment: %+v", got)
+	}
+}
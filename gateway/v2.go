@@ -0,0 +1,168 @@
+package gateway
+
+import (
+	"context"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+)
+
+// V2Client is the subset of the V2 client used by the V2 adapter. It is satisfied by *v2.Client.
+type V2Client interface {
+	GetPayment(ctx context.Context, req v2.GetPaymentRequest) (v2.Payment, error)
+	CancelPayment(ctx context.Context, req v2.CancelPaymentRequest) (v2.CancelPaymentResponse, error)
+	PayWithBillingKey(ctx context.Context, req v2.PayWithBillingKeyRequest) (v2.PayWithBillingKeyResponse, error)
+}
+
+var _ V2Client = (*v2.Client)(nil)
+
+type v2Gateway struct {
+	client V2Client
+}
+
+// NewV2 returns a PaymentGateway over the V2 API. Errors are returned as *v2.Error.
+//
+// The V2 API only returns summaries when cancelling or charging a billing key,
+// so these operations fetch the payment afterwards.
+func NewV2(client V2Client) PaymentGateway {
+	return &v2Gateway{client: client}
+}
+
+func (g *v2Gateway) GetPayment(ctx context.Context, paymentID string) (Payment, error) {
+	p, err := g.client.GetPayment(ctx, v2.GetPaymentRequest{PaymentID: paymentID})
+	if err != nil {
+		return Payment{}, err
+	}
+
+	return FromV2(p), nil
+}
+
+func (g *v2Gateway) CancelPayment(ctx context.Context, req CancelRequest) (Payment, error) {
+	if err := validateCancelRequest(req); err != nil {
+		return Payment{}, err
+	}
+
+	_, err := g.client.CancelPayment(ctx, v2.CancelPaymentRequest{
+		PaymentID:                req.PaymentID,
+		Amount:                   req.Amount,
+		TaxFreeAmount:            req.TaxFreeAmount,
+		Reason:                   req.Reason,
+		CurrentCancellableAmount: req.CurrentCancellableAmount,
+	})
+	if err != nil {
+		return Payment{}, err
+	}
+
+	return g.GetPayment(ctx, req.PaymentID)
+}
+
+func (g *v2Gateway) VerifyAmount(ctx context.Context, paymentID string, expected int64) (Payment, error) {
+	return verifyAmount(ctx, g, paymentID, expected)
+}
+
+func (g *v2Gateway) PayWithBillingKey(ctx context.Context, req BillingKeyPaymentRequest) (Payment, error) {
+	currency := v2.Currency(req.Currency)
+	if currency == "" {
+		currency = v2.CurrencyKRW
+	}
+
+	v2Req := v2.PayWithBillingKeyRequest{
+		PaymentID:  req.OrderID,
+		BillingKey: req.BillingKey,
+		OrderName:  req.OrderName,
+		Amount:     v2.PaymentAmountInput{Total: req.Amount, TaxFree: req.TaxFreeAmount},
+		Currency:   currency,
+	}
+	if req.CustomerName != "" || req.CustomerEmail != "" || req.CustomerPhone != "" {
+		v2Req.Customer = &v2.Customer{
+			Email:       req.CustomerEmail,
+			PhoneNumber: req.CustomerPhone,
+		}
+		if req.CustomerName != "" {
+			v2Req.Customer.Name = &v2.CustomerName{Full: req.CustomerName}
+		}
+	}
+
+	_, err := g.client.PayWithBillingKey(ctx, v2Req)
+	if v2.IsErrorType(err, v2.ErrorTypePgProvider) {
+		return g.declinedPayment(ctx, req.OrderID, err)
+	}
+	if err != nil {
+		return Payment{}, err
+	}
+
+	return g.GetPayment(ctx, req.OrderID)
+}
+
+// declinedPayment returns the failed payment left by a charge the PG declined with declineErr,
+// as the V1 API does. declineErr is returned when there is no such payment.
+func (g *v2Gateway) declinedPayment(ctx context.Context, paymentID string, declineErr error) (Payment, error) {
+	p, err := g.GetPayment(ctx, paymentID)
+	if err != nil || p.Status != StatusFailed {
+		return Payment{}, declineErr
+	}
+
+	return p, nil
+}
+
+// FromV2 normalizes a V2 payment.
+func FromV2(p v2.Payment) Payment {
+	base := p.Common()
+	np := Payment{
+		Version:         V2,
+		ID:              base.ID,
+		OrderID:         base.ID,
+		OrderName:       base.OrderName,
+		Currency:        string(base.Currency),
+		Amount:          base.Amount.Total,
+		CancelledAmount: base.Amount.Cancelled,
+		Raw:             p,
+	}
+	if base.Channel != nil {
+		np.PgProvider = base.Channel.PgProvider
+	}
+
+	var cancellations []v2.PaymentCancellation
+	switch p := p.(type) {
+	case *v2.ReadyPayment:
+		np.Status = StatusReady
+	case *v2.VirtualAccountIssuedPayment:
+		np.Status = StatusVirtualAccountIssued
+		np.PgTxID = p.PgTxID
+	case *v2.PaidPayment:
+		np.Status = StatusPaid
+		np.PgTxID = p.PgTxID
+		np.ReceiptURL = p.ReceiptURL
+		np.PaidAt = p.PaidAt
+	case *v2.PartialCancelledPayment:
+		np.Status = StatusPartialCancelled
+		np.PgTxID = p.PgTxID
+		np.ReceiptURL = p.ReceiptURL
+		np.PaidAt = timeOrZero(p.PaidAt)
+		np.CancelledAt = p.CancelledAt
+		cancellations = p.Cancellations
+	case *v2.CancelledPayment:
+		np.Status = StatusCancelled
+		np.PgTxID = p.PgTxID
+		np.ReceiptURL = p.ReceiptURL
+		np.PaidAt = timeOrZero(p.PaidAt)
+		np.CancelledAt = p.CancelledAt
+		cancellations = p.Cancellations
+	case *v2.FailedPayment:
+		np.Status = StatusFailed
+		np.FailureReason = p.Failure.Reason
+		np.FailedAt = p.FailedAt
+	default:
+		np.Status = StatusUnknown
+	}
+
+	for _, c := range cancellations {
+		np.Cancellations = append(np.Cancellations, Cancellation{
+			Amount:      c.TotalAmount,
+			Reason:      c.Reason,
+			PgTxID:      c.PgCancellationID,
+			CancelledAt: timeOrZero(c.CancelledAt),
+		})
+	}
+
+	return np
+}
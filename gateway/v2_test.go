@@ -0,0 +1,347 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/connectfit-team/go-portone/gateway"
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeV2Client struct {
+	payments  map[string]v2.Payment
+	cancelled []v2.CancelPaymentRequest
+	charged   []v2.PayWithBillingKeyRequest
+	// declineReason makes PayWithBillingKey fail as if the PG declined the charge.
+	declineReason string
+	// declineWithoutPayment makes a declined charge leave no payment behind.
+	declineWithoutPayment bool
+}
+
+func (f *fakeV2Client) GetPayment(_ context.Context, req v2.GetPaymentRequest) (v2.Payment, error) {
+	p, ok := f.payments[req.PaymentID]
+	if !ok {
+		return nil, &v2.Error{StatusCode: 404, Type: v2.ErrorTypePaymentNotFound}
+	}
+
+	return p, nil
+}
+
+func (f *fakeV2Client) CancelPayment(_ context.Context, req v2.CancelPaymentRequest) (v2.CancelPaymentResponse, error) {
+	f.cancelled = append(f.cancelled, req)
+
+	paid := f.payments[req.PaymentID].(*v2.PaidPayment)
+	cancelledAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	cancellation := v2.PaymentCancellation{
+		Status:      v2.PaymentCancellationStatusSucceeded,
+		TotalAmount: paid.Amount.Total,
+		Reason:      req.Reason,
+		CancelledAt: &cancelledAt,
+	}
+
+	cancelled := &v2.CancelledPayment{
+		PaymentBase:   paid.PaymentBase,
+		PaidAt:        &paid.PaidAt,
+		PgTxID:        paid.PgTxID,
+		Cancellations: []v2.PaymentCancellation{cancellation},
+		CancelledAt:   cancelledAt,
+	}
+	cancelled.Status = v2.PaymentStatusCancelled
+	cancelled.Amount.Cancelled = paid.Amount.Total
+	f.payments[req.PaymentID] = cancelled
+
+	return v2.CancelPaymentResponse{Cancellation: cancellation}, nil
+}
+
+func (f *fakeV2Client) PayWithBillingKey(_ context.Context, req v2.PayWithBillingKeyRequest) (v2.PayWithBillingKeyResponse, error) {
+	f.charged = append(f.charged, req)
+
+	if f.declineReason != "" {
+		if !f.declineWithoutPayment {
+			f.payments[req.PaymentID] = &v2.FailedPayment{
+				PaymentBase: v2.PaymentBase{
+					Status:    v2.PaymentStatusFailed,
+					ID:        req.PaymentID,
+					OrderName: req.OrderName,
+					Amount:    v2.PaymentAmount{Total: req.Amount.Total},
+					Currency:  req.Currency,
+				},
+				FailedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Failure:  v2.PaymentFailure{Reason: f.declineReason},
+			}
+		}
+		return v2.PayWithBillingKeyResponse{}, &v2.Error{StatusCode: 400, Type: v2.ErrorTypePgProvider, Message: f.declineReason}
+	}
+
+	paidAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.payments[req.PaymentID] = &v2.PaidPayment{
+		PaymentBase: v2.PaymentBase{
+			Status:    v2.PaymentStatusPaid,
+			ID:        req.PaymentID,
+			OrderName: req.OrderName,
+			Amount:    v2.PaymentAmount{Total: req.Amount.Total, Paid: req.Amount.Total},
+			Currency:  req.Currency,
+		},
+		PaidAt: paidAt,
+		PgTxID: "test_pg_tx_id",
+	}
+
+	return v2.PayWithBillingKeyResponse{Payment: v2.BillingKeyPaymentSummary{PgTxID: "test_pg_tx_id", PaidAt: paidAt}}, nil
+}
+
+func newPaidV2Payment(id string, amount int64) *v2.PaidPayment {
+	return &v2.PaidPayment{
+		PaymentBase: v2.PaymentBase{
+			Status:    v2.PaymentStatusPaid,
+			ID:        id,
+			OrderName: "test_order_name",
+			Amount:    v2.PaymentAmount{Total: amount, Paid: amount},
+			Currency:  v2.CurrencyKRW,
+			Channel:   &v2.SelectedChannel{PgProvider: "TOSSPAYMENTS"},
+		},
+		PaidAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		PgTxID:     "test_pg_tx_id",
+		ReceiptURL: "test_receipt_url",
+	}
+}
+
+func TestV2GetPayment(t *testing.T) {
+	raw := newPaidV2Payment("payment_1", 1000)
+	g := gateway.NewV2(&fakeV2Client{payments: map[string]v2.Payment{"payment_1": raw}})
+
+	got, err := g.GetPayment(context.Background(), "payment_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := gateway.Payment{
+		Version:    gateway.V2,
+		ID:         "payment_1",
+		OrderID:    "payment_1",
+		Status:     gateway.StatusPaid,
+		OrderName:  "test_order_name",
+		Currency:   "KRW",
+		Amount:     1000,
+		PgProvider: "TOSSPAYMENTS",
+		PgTxID:     "test_pg_tx_id",
+		ReceiptURL: "test_receipt_url",
+		PaidAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Raw:        v2.Payment(raw),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected payment (-want +got):\n%s", diff)
+	}
+
+	if p, ok := got.V2(); !ok || p != v2.Payment(raw) {
+		t.Errorf("unexpected raw payment: %+v", got.Raw)
+	}
+}
+
+func TestV2GetPaymentError(t *testing.T) {
+	g := gateway.NewV2(&fakeV2Client{})
+
+	_, err := g.GetPayment(context.Background(), "payment_unknown")
+	if !v2.IsErrorType(err, v2.ErrorTypePaymentNotFound) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestV2CancelPayment(t *testing.T) {
+	client := &fakeV2Client{payments: map[string]v2.Payment{"payment_1": newPaidV2Payment("payment_1", 1000)}}
+	g := gateway.NewV2(client)
+
+	cancellable := int64(1000)
+	got, err := g.CancelPayment(context.Background(), gateway.CancelRequest{
+		PaymentID:                "payment_1",
+		Reason:                   "test_reason",
+		CurrentCancellableAmount: &cancellable,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.cancelled) != 1 || *client.cancelled[0].CurrentCancellableAmount != 1000 {
+		t.Errorf("unexpected cancel requests: %+v", client.cancelled)
+	}
+
+	cancelledAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got.Status != gateway.StatusCancelled || got.CancellableAmount() != 0 || !got.CancelledAt.Equal(cancelledAt) {
+		t.Errorf("unexpected payment: %+v", got)
+	}
+
+	wantCancellations := []gateway.Cancellation{{Amount: 1000, Reason: "test_reason", CancelledAt: cancelledAt}}
+	if diff := cmp.Diff(wantCancellations, got.Cancellations); diff != "" {
+		t.Errorf("unexpected cancellations (-want +got):\n%s", diff)
+	}
+}
+
+func TestV2PayWithBillingKey(t *testing.T) {
+	client := &fakeV2Client{payments: map[string]v2.Payment{}}
+	g := gateway.NewV2(client)
+
+	got, err := g.PayWithBillingKey(context.Background(), gateway.BillingKeyPaymentRequest{
+		OrderID:      "payment_again",
+		BillingKey:   "billing_key_1",
+		OrderName:    "test_order_name",
+		Amount:       9900,
+		CustomerName: "홍길동",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantReq := v2.PayWithBillingKeyRequest{
+		PaymentID:  "payment_again",
+		BillingKey: "billing_key_1",
+		OrderName:  "test_order_name",
+		Customer:   &v2.Customer{Name: &v2.CustomerName{Full: "홍길동"}},
+		Amount:     v2.PaymentAmountInput{Total: 9900},
+		Currency:   v2.CurrencyKRW,
+	}
+	if diff := cmp.Diff([]v2.PayWithBillingKeyRequest{wantReq}, client.charged); diff != "" {
+		t.Errorf("unexpected charge request (-want +got):\n%s", diff)
+	}
+
+	if got.Status != gateway.StatusPaid || got.Amount != 9900 || got.PgTxID != "test_pg_tx_id" {
+		t.Errorf("unexpected payment: %+v", got)
+	}
+}
+
+func TestV2CancelPaymentInvalidAmount(t *testing.T) {
+	client := &fakeV2Client{payments: map[string]v2.Payment{"payment_1": newPaidV2Payment("payment_1", 1000)}}
+	g := gateway.NewV2(client)
+
+	amount := int64(0)
+	_, err := g.CancelPayment(context.Background(), gateway.CancelRequest{PaymentID: "payment_1", Amount: &amount, Reason: "test_reason"})
+	if !errors.Is(err, gateway.ErrInvalidCancelAmount) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if len(client.cancelled) != 0 {
+		t.Errorf("unexpected cancel requests: %+v", client.cancelled)
+	}
+}
+
+func TestFromV2(t *testing.T) {
+	paidAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cancelledAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	failedAt := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	base := v2.PaymentBase{ID: "payment_1", Amount: v2.PaymentAmount{Total: 1000, Cancelled: 300}}
+	cancellations := []v2.PaymentCancellation{{TotalAmount: 300, Reason: "test_reason", PgCancellationID: "pg_cancel_1", CancelledAt: &cancelledAt}}
+	wantCancellations := []gateway.Cancellation{{Amount: 300, Reason: "test_reason", PgTxID: "pg_cancel_1", CancelledAt: cancelledAt}}
+
+	tests := []struct {
+		name    string
+		payment v2.Payment
+		want    gateway.Payment
+	}{
+		{
+			name:    "ready",
+			payment: &v2.ReadyPayment{PaymentBase: base},
+			want:    gateway.Payment{Status: gateway.StatusReady},
+		},
+		{
+			name:    "virtual account issued",
+			payment: &v2.VirtualAccountIssuedPayment{PaymentBase: base, PgTxID: "pg_tx_1"},
+			want:    gateway.Payment{Status: gateway.StatusVirtualAccountIssued, PgTxID: "pg_tx_1"},
+		},
+		{
+			name:    "paid",
+			payment: &v2.PaidPayment{PaymentBase: base, PaidAt: paidAt, PgTxID: "pg_tx_1", ReceiptURL: "receipt_url"},
+			want:    gateway.Payment{Status: gateway.StatusPaid, PgTxID: "pg_tx_1", ReceiptURL: "receipt_url", PaidAt: paidAt},
+		},
+		{
+			name: "partial cancelled",
+			payment: &v2.PartialCancelledPayment{
+				PaymentBase:   base,
+				PaidAt:        &paidAt,
+				PgTxID:        "pg_tx_1",
+				Cancellations: cancellations,
+				CancelledAt:   cancelledAt,
+			},
+			want: gateway.Payment{
+				Status:        gateway.StatusPartialCancelled,
+				PgTxID:        "pg_tx_1",
+				PaidAt:        paidAt,
+				CancelledAt:   cancelledAt,
+				Cancellations: wantCancellations,
+			},
+		},
+		{
+			name: "cancelled",
+			payment: &v2.CancelledPayment{
+				PaymentBase:   base,
+				PaidAt:        &paidAt,
+				PgTxID:        "pg_tx_1",
+				Cancellations: cancellations,
+				CancelledAt:   cancelledAt,
+			},
+			want: gateway.Payment{
+				Status:        gateway.StatusCancelled,
+				PgTxID:        "pg_tx_1",
+				PaidAt:        paidAt,
+				CancelledAt:   cancelledAt,
+				Cancellations: wantCancellations,
+			},
+		},
+		{
+			name:    "failed",
+			payment: &v2.FailedPayment{PaymentBase: base, FailedAt: failedAt, Failure: v2.PaymentFailure{Reason: "test_failure"}},
+			want:    gateway.Payment{Status: gateway.StatusFailed, FailureReason: "test_failure", FailedAt: failedAt},
+		},
+		{
+			name:    "unrecognized",
+			payment: &v2.UnrecognizedPayment{PaymentBase: base},
+			want:    gateway.Payment{Status: gateway.StatusUnknown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			want.Version = gateway.V2
+			want.ID = "payment_1"
+			want.OrderID = "payment_1"
+			want.Amount = 1000
+			want.CancelledAmount = 300
+			want.Raw = tt.payment
+
+			if diff := cmp.Diff(want, gateway.FromV2(tt.payment)); diff != "" {
+				t.Errorf("unexpected payment (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestV2PayWithBillingKeyDeclined(t *testing.T) {
+	req := gateway.BillingKeyPaymentRequest{
+		OrderID:    "payment_again",
+		BillingKey: "billing_key_1",
+		OrderName:  "test_order_name",
+		Amount:     9900,
+	}
+
+	g := gateway.NewV2(&fakeV2Client{payments: map[string]v2.Payment{}, declineReason: "한도초과"})
+
+	got, err := g.PayWithBillingKey(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got.Status != gateway.StatusFailed || got.FailureReason != "한도초과" || !got.FailedAt.Equal(failedAt) {
+		t.Errorf("unexpected payment: %+v", got)
+	}
+
+	// Without failed payment to return, the decline is reported as is.
+	g = gateway.NewV2(&fakeV2Client{payments: map[string]v2.Payment{}, declineReason: "한도초과", declineWithoutPayment: true})
+
+	_, err = g.PayWithBillingKey(context.Background(), req)
+	if !v2.IsErrorType(err, v2.ErrorTypePgProvider) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -12,14 +12,14 @@ const (
 	contentTypeJSON = "application/json"
 )
 
-func mustInitClient(t *testing.T) (*portone.Client, *http.ServeMux) {
+func mustInitClient(t *testing.T, opts ...portone.ClientOption) (*portone.Client, *http.ServeMux) {
 	t.Helper()
 
 	mux := http.NewServeMux()
 	srv := httptest.NewServer(mux)
 	t.Cleanup(srv.Close)
 
-	client, err := portone.NewClient(testRestAPIKey, testRestAPISecret, portone.WithBaseURL(srv.URL))
+	client, err := portone.NewClient(testRestAPIKey, testRestAPISecret, append([]portone.ClientOption{portone.WithBaseURL(srv.URL)}, opts...)...)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -27,10 +27,10 @@ func mustInitClient(t *testing.T) (*portone.Client, *http.ServeMux) {
 	return client, mux
 }
 
-func mustInitClientWithAuthentication(t *testing.T) (*portone.Client, *http.ServeMux) {
+func mustInitClientWithAuthentication(t *testing.T, opts ...portone.ClientOption) (*portone.Client, *http.ServeMux) {
 	t.Helper()
 
-	client, mux := mustInitClient(t)
+	client, mux := mustInitClient(t, opts...)
 
 	mux.HandleFunc("/users/getToken", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
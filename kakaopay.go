@@ -0,0 +1,126 @@
+package portone
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type kakaoPayService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	tier       string
+}
+
+func newKakaoPayService(baseURL *url.URL, httpClient *http.Client, tier string) *kakaoPayService {
+	return &kakaoPayService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		tier:       tier,
+	}
+}
+
+// KakaoPayOrderStatus is the status of a Kakao Pay order.
+type KakaoPayOrderStatus string
+
+const (
+	KakaoPayOrderStatusReady             KakaoPayOrderStatus = "READY"
+	KakaoPayOrderStatusSendTMS           KakaoPayOrderStatus = "SEND_TMS"
+	KakaoPayOrderStatusOpenPayment       KakaoPayOrderStatus = "OPEN_PAYMENT"
+	KakaoPayOrderStatusSelectMethod      KakaoPayOrderStatus = "SELECT_METHOD"
+	KakaoPayOrderStatusArsWaiting        KakaoPayOrderStatus = "ARS_WAITING"
+	KakaoPayOrderStatusAuthPassword      KakaoPayOrderStatus = "AUTH_PASSWORD"
+	KakaoPayOrderStatusIssuedSID         KakaoPayOrderStatus = "ISSUED_SID"
+	KakaoPayOrderStatusSuccessPayment    KakaoPayOrderStatus = "SUCCESS_PAYMENT"
+	KakaoPayOrderStatusPartCancelPayment KakaoPayOrderStatus = "PART_CANCEL_PAYMENT"
+	KakaoPayOrderStatusCancelPayment     KakaoPayOrderStatus = "CANCEL_PAYMENT"
+	KakaoPayOrderStatusFailAuthPassword  KakaoPayOrderStatus = "FAIL_AUTH_PASSWORD"
+	KakaoPayOrderStatusQuitPayment       KakaoPayOrderStatus = "QUIT_PAYMENT"
+	KakaoPayOrderStatusFailPayment       KakaoPayOrderStatus = "FAIL_PAYMENT"
+)
+
+// KakaoPayAmount represents the amount breakdown of a Kakao Pay order.
+type KakaoPayAmount struct {
+	Total    int64 `json:"total"`
+	TaxFree  int64 `json:"tax_free"`
+	Vat      int64 `json:"vat"`
+	Point    int64 `json:"point"`
+	Discount int64 `json:"discount"`
+}
+
+// KakaoPayOrder represents an order as seen by Kakao Pay.
+type KakaoPayOrder struct {
+	Cid                   string              `json:"cid"`
+	Tid                   string              `json:"tid"`
+	Status                KakaoPayOrderStatus `json:"status"`
+	PartnerOrderID        string              `json:"partner_order_id"`
+	PartnerUserID         string              `json:"partner_user_id"`
+	PaymentMethodType     string              `json:"payment_method_type"`
+	ItemName              string              `json:"item_name"`
+	Quantity              int                 `json:"quantity"`
+	Amount                KakaoPayAmount      `json:"amount"`
+	CanceledAmount        KakaoPayAmount      `json:"canceled_amount"`
+	CancelAvailableAmount KakaoPayAmount      `json:"cancel_available_amount"`
+	CreatedAt             int64               `json:"created_at"`
+	ApprovedAt            int64               `json:"approved_at"`
+	CanceledAt            int64               `json:"canceled_at"`
+}
+
+// GetKakaoPayOrdersRequest represents a request for 'GET /kakao/payment/orders'.
+type GetKakaoPayOrdersRequest struct {
+	// PaymentRequestDate is the day the orders were requested, formatted as YYYYMMDD.
+	PaymentRequestDate string
+	Cid                string
+	// Page is the cursor of the page to fetch. Use the NextPage of the previous response to walk through every order.
+	Page  int
+	Limit int
+}
+
+// GetKakaoPayOrdersResponse represents a response of 'GET /kakao/payment/orders'.
+type GetKakaoPayOrdersResponse struct {
+	CommonResponse
+	Response struct {
+		TotalCount   int             `json:"total_count"`
+		PreviousPage int             `json:"previous_page"`
+		NextPage     int             `json:"next_page"`
+		Orders       []KakaoPayOrder `json:"orders"`
+	} `json:"response"`
+}
+
+// HasNextPage reports whether more orders can be fetched with the NextPage cursor.
+func (r GetKakaoPayOrdersResponse) HasNextPage() bool {
+	return r.Response.NextPage > 0
+}
+
+// GetKakaoPayOrders returns the orders of Kakao Pay.
+func (ks *kakaoPayService) GetKakaoPayOrders(ctx context.Context, req GetKakaoPayOrdersRequest) (GetKakaoPayOrdersResponse, error) {
+	u := ks.baseURL.JoinPath("/payment/orders")
+	q := u.Query()
+	if req.PaymentRequestDate != "" {
+		q.Set("payment_request_date", req.PaymentRequestDate)
+	}
+	if req.Cid != "" {
+		q.Set("cid", req.Cid)
+	}
+	if req.Page != 0 {
+		q.Set("page", strconv.Itoa(req.Page))
+	}
+	if req.Limit != 0 {
+		q.Set("limit", strconv.Itoa(req.Limit))
+	}
+	u.RawQuery = q.Encode()
+
+	httpReq, err := newRequest(ctx, ks.tier, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return GetKakaoPayOrdersResponse{}, err
+	}
+
+	var resp GetKakaoPayOrdersResponse
+	err = do(ks.httpClient, httpReq, &resp)
+	if err != nil {
+		return GetKakaoPayOrdersResponse{}, err
+	}
+
+	return resp, nil
+}
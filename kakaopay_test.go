@@ -0,0 +1,79 @@
+package portone_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetKakaoPayOrders(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/kakao/payment/orders", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		q := r.URL.Query()
+		if q.Get("page") != "2" || q.Get("limit") != "10" || q.Get("payment_request_date") != "20230101" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"total_count": 11,
+				"previous_page": 1,
+				"next_page": 0,
+				"orders": [
+					{
+						"cid": "test_cid",
+						"tid": "test_tid",
+						"status": "SUCCESS_PAYMENT",
+						"partner_order_id": "test_merchant_uid",
+						"item_name": "test_item_name",
+						"quantity": 1,
+						"amount": {"total": 1000, "vat": 91},
+						"approved_at": 1672531200
+					}
+				]
+			}
+		}`))
+	})
+
+	resp, err := client.GetKakaoPayOrders(context.Background(), portone.GetKakaoPayOrdersRequest{
+		PaymentRequestDate: "20230101",
+		Page:               2,
+		Limit:              10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.HasNextPage() {
+		t.Error("expected last page")
+	}
+
+	want := []portone.KakaoPayOrder{
+		{
+			Cid:            "test_cid",
+			Tid:            "test_tid",
+			Status:         portone.KakaoPayOrderStatusSuccessPayment,
+			PartnerOrderID: "test_merchant_uid",
+			ItemName:       "test_item_name",
+			Quantity:       1,
+			Amount:         portone.KakaoPayAmount{Total: 1000, Vat: 91},
+			ApprovedAt:     1672531200,
+		},
+	}
+
+	if diff := cmp.Diff(want, resp.Response.Orders); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
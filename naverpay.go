@@ -0,0 +1,301 @@
+package portone
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type naverPayService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	tier       string
+}
+
+func newNaverPayService(baseURL *url.URL, httpClient *http.Client, tier string) *naverPayService {
+	return &naverPayService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		tier:       tier,
+	}
+}
+
+// NaverProductOrderStatus is the status of a Naver Pay product order.
+type NaverProductOrderStatus string
+
+const (
+	NaverProductOrderStatusPaymentWaiting      NaverProductOrderStatus = "PAYMENT_WAITING"
+	NaverProductOrderStatusPayed               NaverProductOrderStatus = "PAYED"
+	NaverProductOrderStatusDelivering          NaverProductOrderStatus = "DELIVERING"
+	NaverProductOrderStatusDelivered           NaverProductOrderStatus = "DELIVERED"
+	NaverProductOrderStatusPurchaseDecided     NaverProductOrderStatus = "PURCHASE_DECIDED"
+	NaverProductOrderStatusExchanged           NaverProductOrderStatus = "EXCHANGED"
+	NaverProductOrderStatusCanceled            NaverProductOrderStatus = "CANCELED"
+	NaverProductOrderStatusReturned            NaverProductOrderStatus = "RETURNED"
+	NaverProductOrderStatusCanceledByNoPayment NaverProductOrderStatus = "CANCELED_BY_NOPAYMENT"
+)
+
+// NaverClaimType is the type of claim raised on a Naver Pay product order.
+type NaverClaimType string
+
+const (
+	NaverClaimTypeCancel                   NaverClaimType = "CANCEL"
+	NaverClaimTypeReturn                   NaverClaimType = "RETURN"
+	NaverClaimTypeExchange                 NaverClaimType = "EXCHANGE"
+	NaverClaimTypePurchaseDecisionHoldback NaverClaimType = "PURCHASE_DECISION_HOLDBACK"
+	NaverClaimTypeAdminCancel              NaverClaimType = "ADMIN_CANCEL"
+)
+
+// NaverShippingAddress represents the shipping address of a Naver Pay product order.
+type NaverShippingAddress struct {
+	Name          string `json:"name"`
+	BaseAddress   string `json:"base_address"`
+	DetailAddress string `json:"detail_address"`
+	Zipcode       string `json:"zipcode"`
+	Tel1          string `json:"tel1"`
+	Tel2          string `json:"tel2"`
+}
+
+// NaverProductOrder represents a Naver Pay product order.
+type NaverProductOrder struct {
+	ProductOrderID     string                  `json:"product_order_id"`
+	ProductOrderStatus NaverProductOrderStatus `json:"product_order_status"`
+	ClaimType          NaverClaimType          `json:"claim_type"`
+	ClaimStatus        string                  `json:"claim_status"`
+	ProductID          string                  `json:"product_id"`
+	ProductName        string                  `json:"product_name"`
+	ProductOptionID    string                  `json:"product_option_id"`
+	ProductOptionName  string                  `json:"product_option_name"`
+	ProductAmount      int64                   `json:"product_amount"`
+	Quantity           int                     `json:"quantity"`
+	ShippingDue        int64                   `json:"shipping_due"`
+	ShippingFee        int64                   `json:"shipping_fee"`
+	ShippingAddress    *NaverShippingAddress   `json:"shipping_address"`
+}
+
+// NaverProductOrdersResponse represents a response of the Naver Pay endpoints returning product orders.
+type NaverProductOrdersResponse struct {
+	CommonResponse
+	Response []NaverProductOrder `json:"response"`
+}
+
+// GetNaverProductOrders returns the product orders of a Naver Pay payment.
+func (ns *naverPayService) GetNaverProductOrders(ctx context.Context, impUID string) (NaverProductOrdersResponse, error) {
+	u := ns.baseURL.JoinPath("/payments", impUID, "/naver/product-orders")
+	return ns.doProductOrders(ctx, http.MethodGet, u, nil)
+}
+
+// NaverProductOrderResponse represents a response of 'GET /naver/product-orders/{product_order_id}'.
+type NaverProductOrderResponse struct {
+	CommonResponse
+	Response NaverProductOrder `json:"response"`
+}
+
+// GetNaverProductOrder returns a single Naver Pay product order.
+func (ns *naverPayService) GetNaverProductOrder(ctx context.Context, productOrderID string) (NaverProductOrderResponse, error) {
+	u := ns.baseURL.JoinPath("/naver/product-orders", productOrderID)
+	httpReq, err := newRequest(ctx, ns.tier, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return NaverProductOrderResponse{}, err
+	}
+
+	var resp NaverProductOrderResponse
+	err = do(ns.httpClient, httpReq, &resp)
+	if err != nil {
+		return NaverProductOrderResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// NaverCancelReason is the reason of a Naver Pay product order cancellation.
+type NaverCancelReason string
+
+const (
+	NaverCancelReasonProductUnsatisfied NaverCancelReason = "PRODUCT_UNSATISFIED"
+	NaverCancelReasonDelayedDelivery    NaverCancelReason = "DELAYED_DELIVERY"
+	NaverCancelReasonSoldOut            NaverCancelReason = "SOLD_OUT"
+)
+
+// CancelNaverProductOrdersRequest represents a request for 'POST /payments/{imp_uid}/naver/cancel'.
+type CancelNaverProductOrdersRequest struct {
+	ImpUID          string            `json:"-"`
+	ProductOrderIDs []string          `json:"product_order_id"`
+	Reason          NaverCancelReason `json:"reason"`
+}
+
+// CancelNaverProductOrders cancels Naver Pay product orders.
+func (ns *naverPayService) CancelNaverProductOrders(ctx context.Context, req CancelNaverProductOrdersRequest) (NaverProductOrdersResponse, error) {
+	return ns.doProductOrders(ctx, http.MethodPost, ns.actionURL(req.ImpUID, "cancel"), req)
+}
+
+// NaverDeliveryMethod is the delivery method of a Naver Pay product order.
+type NaverDeliveryMethod string
+
+const (
+	NaverDeliveryMethodDelivery       NaverDeliveryMethod = "DELIVERY"
+	NaverDeliveryMethodGDFWIssueSvc   NaverDeliveryMethod = "GDFW_ISSUE_SVC"
+	NaverDeliveryMethodVisitReceipt   NaverDeliveryMethod = "VISIT_RECEIPT"
+	NaverDeliveryMethodDirectDelivery NaverDeliveryMethod = "DIRECT_DELIVERY"
+	NaverDeliveryMethodQuickSvc       NaverDeliveryMethod = "QUICK_SVC"
+	NaverDeliveryMethodNothing        NaverDeliveryMethod = "NOTHING"
+)
+
+// ShipNaverProductOrdersRequest represents a request for 'POST /payments/{imp_uid}/naver/ship'.
+type ShipNaverProductOrdersRequest struct {
+	ImpUID          string              `json:"-"`
+	ProductOrderIDs []string            `json:"product_order_id"`
+	DeliveryMethod  NaverDeliveryMethod `json:"delivery_method"`
+	DispatchedAt    int64               `json:"dispatched_at"`
+	DeliveryCompany string              `json:"delivery_company,omitempty"`
+	TrackingNumber  string              `json:"tracking_number,omitempty"`
+}
+
+// ShipNaverProductOrders dispatches Naver Pay product orders.
+func (ns *naverPayService) ShipNaverProductOrders(ctx context.Context, req ShipNaverProductOrdersRequest) (NaverProductOrdersResponse, error) {
+	return ns.doProductOrders(ctx, http.MethodPost, ns.actionURL(req.ImpUID, "ship"), req)
+}
+
+// NaverProductOrdersRequest represents a request for the Naver Pay endpoints only taking product order IDs.
+type NaverProductOrdersRequest struct {
+	ImpUID          string   `json:"-"`
+	ProductOrderIDs []string `json:"product_order_id"`
+}
+
+// PlaceNaverProductOrders confirms Naver Pay product orders (발주 처리).
+func (ns *naverPayService) PlaceNaverProductOrders(ctx context.Context, req NaverProductOrdersRequest) (NaverProductOrdersResponse, error) {
+	return ns.doProductOrders(ctx, http.MethodPost, ns.actionURL(req.ImpUID, "place"), req)
+}
+
+// ApproveCancelNaverProductOrders approves the cancellation requested by the buyer of Naver Pay product orders.
+func (ns *naverPayService) ApproveCancelNaverProductOrders(ctx context.Context, req NaverProductOrdersRequest) (NaverProductOrdersResponse, error) {
+	return ns.doProductOrders(ctx, http.MethodPost, ns.actionURL(req.ImpUID, "approve-cancel"), req)
+}
+
+// NaverReturnRequest represents a request for the Naver Pay return approval and rejection endpoints.
+type NaverReturnRequest struct {
+	ImpUID          string   `json:"-"`
+	ProductOrderIDs []string `json:"product_order_id"`
+	Memo            string   `json:"memo,omitempty"`
+	ExtraCharge     int64    `json:"extra_charge,omitempty"`
+}
+
+// ApproveReturnNaverProductOrders approves the return requested by the buyer of Naver Pay product orders.
+func (ns *naverPayService) ApproveReturnNaverProductOrders(ctx context.Context, req NaverReturnRequest) (NaverProductOrdersResponse, error) {
+	return ns.doProductOrders(ctx, http.MethodPost, ns.actionURL(req.ImpUID, "approve-return"), req)
+}
+
+// RejectReturnNaverProductOrders rejects the return requested by the buyer of Naver Pay product orders.
+func (ns *naverPayService) RejectReturnNaverProductOrders(ctx context.Context, req NaverReturnRequest) (NaverProductOrdersResponse, error) {
+	return ns.doProductOrders(ctx, http.MethodPost, ns.actionURL(req.ImpUID, "reject-return"), req)
+}
+
+// NaverWithholdReturnType is the reason a Naver Pay return is withheld.
+type NaverWithholdReturnType string
+
+const (
+	NaverWithholdReturnTypeReturnDeliveryFee            NaverWithholdReturnType = "RETURN_DELIVERYFEE"
+	NaverWithholdReturnTypeExtraFee                     NaverWithholdReturnType = "EXTRAFEEE"
+	NaverWithholdReturnTypeReturnDeliveryFeeAndExtraFee NaverWithholdReturnType = "RETURN_DELIVERYFEE_AND_EXTRAFEEE"
+	NaverWithholdReturnTypeReturnProductNotDelivered    NaverWithholdReturnType = "RETURN_PRODUCT_NOT_DELIVERED"
+	NaverWithholdReturnTypeEtc                          NaverWithholdReturnType = "ETC"
+)
+
+// WithholdReturnNaverProductOrdersRequest represents a request for 'POST /payments/{imp_uid}/naver/withhold-return'.
+type WithholdReturnNaverProductOrdersRequest struct {
+	ImpUID          string                  `json:"-"`
+	ProductOrderIDs []string                `json:"product_order_id"`
+	Type            NaverWithholdReturnType `json:"type"`
+	Memo            string                  `json:"memo,omitempty"`
+	ExtraCharge     int64                   `json:"extra_charge,omitempty"`
+}
+
+// WithholdReturnNaverProductOrders withholds the return requested by the buyer of Naver Pay product orders.
+func (ns *naverPayService) WithholdReturnNaverProductOrders(ctx context.Context, req WithholdReturnNaverProductOrdersRequest) (NaverProductOrdersResponse, error) {
+	return ns.doProductOrders(ctx, http.MethodPost, ns.actionURL(req.ImpUID, "withhold-return"), req)
+}
+
+// NaverReviewType is the type of a Naver Pay review.
+type NaverReviewType string
+
+const (
+	NaverReviewTypeGeneral NaverReviewType = "general"
+	NaverReviewTypePremium NaverReviewType = "premium"
+)
+
+// NaverReview represents a buyer review of a Naver Pay product order.
+type NaverReview struct {
+	ReviewID          string `json:"review_id"`
+	Title             string `json:"title"`
+	Content           string `json:"content"`
+	Score             int    `json:"score"`
+	ProductOrderID    string `json:"product_order_id"`
+	ProductID         string `json:"product_id"`
+	ProductName       string `json:"product_name"`
+	ProductOptionName string `json:"product_option_name"`
+	Writer            string `json:"writer"`
+	CreatedAt         int64  `json:"created_at"`
+	ModifiedAt        int64  `json:"modified_at"`
+}
+
+// GetNaverReviewsRequest represents a request for 'GET /naver/reviews'.
+type GetNaverReviewsRequest struct {
+	From       int64
+	To         int64
+	ReviewType NaverReviewType
+}
+
+// GetNaverReviewsResponse represents a response of 'GET /naver/reviews'.
+type GetNaverReviewsResponse struct {
+	CommonResponse
+	Response []NaverReview `json:"response"`
+}
+
+// GetNaverReviews returns the Naver Pay reviews written in the given period.
+func (ns *naverPayService) GetNaverReviews(ctx context.Context, req GetNaverReviewsRequest) (GetNaverReviewsResponse, error) {
+	u := ns.baseURL.JoinPath("/naver/reviews")
+	q := u.Query()
+	if req.From != 0 {
+		q.Set("from", strconv.FormatInt(req.From, 10))
+	}
+	if req.To != 0 {
+		q.Set("to", strconv.FormatInt(req.To, 10))
+	}
+	if req.ReviewType != "" {
+		q.Set("review_type", string(req.ReviewType))
+	}
+	u.RawQuery = q.Encode()
+
+	httpReq, err := newRequest(ctx, ns.tier, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return GetNaverReviewsResponse{}, err
+	}
+
+	var resp GetNaverReviewsResponse
+	err = do(ns.httpClient, httpReq, &resp)
+	if err != nil {
+		return GetNaverReviewsResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (ns *naverPayService) actionURL(impUID, action string) *url.URL {
+	return ns.baseURL.JoinPath("/payments", impUID, "/naver", action)
+}
+
+func (ns *naverPayService) doProductOrders(ctx context.Context, method string, u *url.URL, body any) (NaverProductOrdersResponse, error) {
+	httpReq, err := newRequest(ctx, ns.tier, method, u.String(), body)
+	if err != nil {
+		return NaverProductOrdersResponse{}, err
+	}
+
+	var resp NaverProductOrdersResponse
+	err = do(ns.httpClient, httpReq, &resp)
+	if err != nil {
+		return NaverProductOrdersResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,177 @@
+package portone_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetNaverProductOrders(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/test_imp_uid/naver/product-orders", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": [
+				{
+					"product_order_id": "test_product_order_id",
+					"product_order_status": "PAYED",
+					"product_id": "test_product_id",
+					"product_name": "test_product_name",
+					"product_amount": 1000,
+					"quantity": 2,
+					"shipping_fee": 2500,
+					"shipping_address": {
+						"name": "test_name",
+						"base_address": "test_base_address",
+						"zipcode": "12345"
+					}
+				}
+			]
+		}`))
+	})
+
+	resp, err := client.GetNaverProductOrders(context.Background(), "test_imp_uid")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []portone.NaverProductOrder{
+		{
+			ProductOrderID:     "test_product_order_id",
+			ProductOrderStatus: portone.NaverProductOrderStatusPayed,
+			ProductID:          "test_product_id",
+			ProductName:        "test_product_name",
+			ProductAmount:      1000,
+			Quantity:           2,
+			ShippingFee:        2500,
+			ShippingAddress: &portone.NaverShippingAddress{
+				Name:        "test_name",
+				BaseAddress: "test_base_address",
+				Zipcode:     "12345",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, resp.Response); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
+
+func TestShipNaverProductOrders(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/test_imp_uid/naver/ship", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"product_order_id": []any{"test_product_order_id"},
+			"delivery_method":  "DELIVERY",
+			"dispatched_at":    float64(1600000000),
+			"delivery_company": "CJGLS",
+			"tracking_number":  "test_tracking_number",
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": [
+				{
+					"product_order_id": "test_product_order_id",
+					"product_order_status": "DELIVERING"
+				}
+			]
+		}`))
+	})
+
+	resp, err := client.ShipNaverProductOrders(context.Background(), portone.ShipNaverProductOrdersRequest{
+		ImpUID:          "test_imp_uid",
+		ProductOrderIDs: []string{"test_product_order_id"},
+		DeliveryMethod:  portone.NaverDeliveryMethodDelivery,
+		DispatchedAt:    1600000000,
+		DeliveryCompany: "CJGLS",
+		TrackingNumber:  "test_tracking_number",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Response) != 1 || resp.Response[0].ProductOrderStatus != portone.NaverProductOrderStatusDelivering {
+		t.Errorf("unexpected response: %+v", resp.Response)
+	}
+}
+
+func TestGetNaverReviews(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/naver/reviews", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		q := r.URL.Query()
+		if q.Get("from") != "1600000000" || q.Get("to") != "1700000000" || q.Get("review_type") != "premium" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": [
+				{
+					"review_id": "test_review_id",
+					"title": "test_title",
+					"score": 5,
+					"product_order_id": "test_product_order_id"
+				}
+			]
+		}`))
+	})
+
+	resp, err := client.GetNaverReviews(context.Background(), portone.GetNaverReviewsRequest{
+		From:       1600000000,
+		To:         1700000000,
+		ReviewType: portone.NaverReviewTypePremium,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []portone.NaverReview{
+		{
+			ReviewID:       "test_review_id",
+			Title:          "test_title",
+			Score:          5,
+			ProductOrderID: "test_product_order_id",
+		},
+	}
+
+	if diff := cmp.Diff(want, resp.Response); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
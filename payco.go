@@ -0,0 +1,61 @@
+package portone
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type paycoService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	tier       string
+}
+
+func newPaycoService(baseURL *url.URL, httpClient *http.Client, tier string) *paycoService {
+	return &paycoService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		tier:       tier,
+	}
+}
+
+// PaycoOrderStatus is the status of a Payco order.
+type PaycoOrderStatus string
+
+const (
+	PaycoOrderStatusDeliveryStart    PaycoOrderStatus = "DELIVERY_START"
+	PaycoOrderStatusPurchaseDecision PaycoOrderStatus = "PURCHASE_DECISION"
+	PaycoOrderStatusCanceled         PaycoOrderStatus = "CANCELED"
+)
+
+// UpdatePaycoOrderStatusRequest represents a request for 'POST /payco/orders/status/{imp_uid}'.
+type UpdatePaycoOrderStatusRequest struct {
+	ImpUID string           `json:"-"`
+	Status PaycoOrderStatus `json:"status"`
+}
+
+// UpdatePaycoOrderStatusResponse represents a response of 'POST /payco/orders/status/{imp_uid}'.
+type UpdatePaycoOrderStatusResponse struct {
+	CommonResponse
+	Response struct {
+		Status PaycoOrderStatus `json:"status"`
+	} `json:"response"`
+}
+
+// UpdatePaycoOrderStatus synchronizes the status of a Payco order.
+func (ps *paycoService) UpdatePaycoOrderStatus(ctx context.Context, req UpdatePaycoOrderStatusRequest) (UpdatePaycoOrderStatusResponse, error) {
+	u := ps.baseURL.JoinPath("/orders/status", req.ImpUID)
+	httpReq, err := newRequest(ctx, ps.tier, http.MethodPost, u.String(), req)
+	if err != nil {
+		return UpdatePaycoOrderStatusResponse{}, err
+	}
+
+	var resp UpdatePaycoOrderStatusResponse
+	err = do(ps.httpClient, httpReq, &resp)
+	if err != nil {
+		return UpdatePaycoOrderStatusResponse{}, err
+	}
+
+	return resp, nil
+}
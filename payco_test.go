@@ -0,0 +1,51 @@
+package portone_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+func TestUpdatePaycoOrderStatus(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payco/orders/status/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if body["status"] != "DELIVERY_START" {
+			t.Errorf("unexpected status: %s", body["status"])
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"status": "DELIVERY_START"
+			}
+		}`))
+	})
+
+	resp, err := client.UpdatePaycoOrderStatus(context.Background(), portone.UpdatePaycoOrderStatusRequest{
+		ImpUID: "test_imp_uid",
+		Status: portone.PaycoOrderStatusDeliveryStart,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Response.Status != portone.PaycoOrderStatusDeliveryStart {
+		t.Errorf("unexpected status: %s", resp.Response.Status)
+	}
+}
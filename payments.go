@@ -4,17 +4,20 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 type paymentsService struct {
 	httpClient *http.Client
 	baseURL    *url.URL
+	tier       string
 }
 
-func newPaymentsService(baseURL *url.URL, httpClient *http.Client) *paymentsService {
+func newPaymentsService(baseURL *url.URL, httpClient *http.Client, tier string) *paymentsService {
 	return &paymentsService{
 		httpClient: httpClient,
 		baseURL:    baseURL,
+		tier:       tier,
 	}
 }
 
@@ -36,7 +39,7 @@ type CreatePaymentIntentResponse struct {
 // CreatePaymentIntent creates a new payment intent.
 func (ps *paymentsService) CreatePaymentIntent(ctx context.Context, req CreatePaymentIntentRequest) (CreatePaymentIntentResponse, error) {
 	u := ps.baseURL.JoinPath("/prepare")
-	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	httpReq, err := newRequest(ctx, ps.tier, http.MethodPost, u.String(), req)
 	if err != nil {
 		return CreatePaymentIntentResponse{}, err
 	}
@@ -50,57 +53,56 @@ func (ps *paymentsService) CreatePaymentIntent(ctx context.Context, req CreatePa
 	return resp, nil
 }
 
+// GetPaymentResponse represents a response of 'GET /payments/{imp_uid}'.
 type GetPaymentResponse struct {
 	CommonResponse
-	Response struct {
-		ImpUID            string          `json:"imp_uid"`
-		MerchantUID       string          `json:"merchant_uid"`
-		PayMethod         string          `json:"pay_method"`
-		Channel           string          `json:"channel"`
-		PgProvider        string          `json:"pg_provider"`
-		EmbPgProvider     string          `json:"emb_pg_provider"`
-		PgTid             string          `json:"pg_tid"`
-		PgID              string          `json:"pg_id"`
-		Escrow            bool            `json:"escrow"`
-		ApplyNum          string          `json:"apply_num"`
-		BankCode          string          `json:"bank_code"`
-		BankName          string          `json:"bank_name"`
-		CardCode          string          `json:"card_code"`
-		CardName          string          `json:"card_name"`
-		CardQuota         int             `json:"card_quota"`
-		CardNumber        string          `json:"card_number"`
-		CardType          int             `json:"card_type"`
-		VbankCode         string          `json:"vbank_code"`
-		VbankName         string          `json:"vbank_name"`
-		VbankNum          string          `json:"vbank_num"`
-		VbankHolder       string          `json:"vbank_holder"`
-		VbankDate         int             `json:"vbank_date"`
-		VbankIssuedAt     int             `json:"vbank_issued_at"`
-		Name              string          `json:"name"`
-		Amount            int             `json:"amount"`
-		CancelAmount      int             `json:"cancel_amount"`
-		Currency          string          `json:"currency"`
-		BuyerName         string          `json:"buyer_name"`
-		BuyerEmail        string          `json:"buyer_email"`
-		BuyerTel          string          `json:"buyer_tel"`
-		BuyerAddr         string          `json:"buyer_addr"`
-		BuyerPostcode     string          `json:"buyer_postcode"`
-		CustomData        string          `json:"custom_data"`
-		UserAgent         string          `json:"user_agent"`
-		Status            string          `json:"status"`
-		StartedAt         int             `json:"started_at"`
-		PaidAt            int             `json:"paid_at"`
-		FailedAt          int             `json:"failed_at"`
-		CancelledAt       int             `json:"cancelled_at"`
-		FailReason        string          `json:"fail_reason"`
-		CancelReason      string          `json:"cancel_reason"`
-		ReceiptURL        string          `json:"receipt_url"`
-		CancelHistory     []CancelHistory `json:"cancel_history"`
-		CancelReceiptUrls []string        `json:"cancel_receipt_urls"`
-		CashReceiptIssued bool            `json:"cash_receipt_issued"`
-		CustomerUID       string          `json:"customer_uid"`
-		CustomerUIDUsage  string          `json:"customer_uid_usage"`
-	} `json:"response"`
+	Response Payment `json:"response"`
+}
+
+// Payment is a payment returned by PortOne.
+type Payment struct {
+	ImpUID        string `json:"imp_uid"`
+	MerchantUID   string `json:"merchant_uid"`
+	PayMethod     string `json:"pay_method"`
+	Channel       string `json:"channel"`
+	PgProvider    string `json:"pg_provider"`
+	EmbPgProvider string `json:"emb_pg_provider"`
+	PgTid         string `json:"pg_tid"`
+	PgID          string `json:"pg_id"`
+	Escrow        bool   `json:"escrow"`
+	ApplyNum      string `json:"apply_num"`
+	BankCode      string `json:"bank_code"`
+	BankName      string `json:"bank_name"`
+	CardCode      string `json:"card_code"`
+	CardName      string `json:"card_name"`
+	CardQuota     int    `json:"card_quota"`
+	CardNumber    string `json:"card_number"`
+	CardType      int    `json:"card_type"`
+	Vbank
+	Name              string          `json:"name"`
+	Amount            int             `json:"amount"`
+	CancelAmount      int             `json:"cancel_amount"`
+	Currency          string          `json:"currency"`
+	BuyerName         string          `json:"buyer_name"`
+	BuyerEmail        string          `json:"buyer_email"`
+	BuyerTel          string          `json:"buyer_tel"`
+	BuyerAddr         string          `json:"buyer_addr"`
+	BuyerPostcode     string          `json:"buyer_postcode"`
+	CustomData        string          `json:"custom_data"`
+	UserAgent         string          `json:"user_agent"`
+	Status            string          `json:"status"`
+	StartedAt         int             `json:"started_at"`
+	PaidAt            int             `json:"paid_at"`
+	FailedAt          int             `json:"failed_at"`
+	CancelledAt       int             `json:"cancelled_at"`
+	FailReason        string          `json:"fail_reason"`
+	CancelReason      string          `json:"cancel_reason"`
+	ReceiptURL        string          `json:"receipt_url"`
+	CancelHistory     []CancelHistory `json:"cancel_history"`
+	CancelReceiptUrls []string        `json:"cancel_receipt_urls"`
+	CashReceiptIssued bool            `json:"cash_receipt_issued"`
+	CustomerUID       string          `json:"customer_uid"`
+	CustomerUIDUsage  string          `json:"customer_uid_usage"`
 }
 
 type CancelHistory struct {
@@ -113,7 +115,7 @@ type CancelHistory struct {
 
 func (ps *paymentsService) GetPayment(ctx context.Context, paymentID string) (GetPaymentResponse, error) {
 	u := ps.baseURL.JoinPath(paymentID)
-	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	httpReq, err := newRequest(ctx, ps.tier, http.MethodGet, u.String(), nil)
 	if err != nil {
 		return GetPaymentResponse{}, err
 	}
@@ -126,3 +128,137 @@ func (ps *paymentsService) GetPayment(ctx context.Context, paymentID string) (Ge
 
 	return resp, nil
 }
+
+// FindPaymentResponse represents a response of 'GET /payments/find/{merchant_uid}'.
+type FindPaymentResponse struct {
+	CommonResponse
+	Response Payment `json:"response"`
+}
+
+// FindPayment returns the latest payment made for a merchant_uid.
+func (ps *paymentsService) FindPayment(ctx context.Context, merchantUID string) (FindPaymentResponse, error) {
+	u := ps.baseURL.JoinPath("/find", merchantUID)
+	httpReq, err := newRequest(ctx, ps.tier, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return FindPaymentResponse{}, err
+	}
+
+	var resp FindPaymentResponse
+	err = do(ps.httpClient, httpReq, &resp)
+	if err != nil {
+		return FindPaymentResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// PaymentStatusAll lists payments regardless of their status in GetPaymentsByStatus.
+const PaymentStatusAll = "all"
+
+// GetPaymentsByStatusRequest represents a request for 'GET /payments/status/{payment_status}'.
+type GetPaymentsByStatusRequest struct {
+	// Status is one of ready, paid, cancelled, failed or PaymentStatusAll.
+	Status string
+	// Page starts at 1.
+	Page  int
+	Limit int
+	// From and To are UNIX timestamps bounding the payments by the time of their last status change.
+	From int64
+	To   int64
+	// Sorting is one of -started, started, -paid, paid, -updated and updated. The '-' prefix sorts in descending order.
+	Sorting string
+}
+
+// GetPaymentsByStatusResponse represents a response of 'GET /payments/status/{payment_status}'.
+type GetPaymentsByStatusResponse struct {
+	CommonResponse
+	Response struct {
+		Total    int       `json:"total"`
+		Previous int       `json:"previous"`
+		Next     int       `json:"next"`
+		List     []Payment `json:"list"`
+	} `json:"response"`
+}
+
+// HasNextPage reports whether more payments can be fetched with the Next page.
+func (r GetPaymentsByStatusResponse) HasNextPage() bool {
+	return r.Response.Next > 0
+}
+
+// GetPaymentsByStatus returns the payments in a status, page by page.
+func (ps *paymentsService) GetPaymentsByStatus(ctx context.Context, req GetPaymentsByStatusRequest) (GetPaymentsByStatusResponse, error) {
+	u := ps.baseURL.JoinPath("/status", req.Status)
+	q := u.Query()
+	if req.Page != 0 {
+		q.Set("page", strconv.Itoa(req.Page))
+	}
+	if req.Limit != 0 {
+		q.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.From != 0 {
+		q.Set("from", strconv.FormatInt(req.From, 10))
+	}
+	if req.To != 0 {
+		q.Set("to", strconv.FormatInt(req.To, 10))
+	}
+	if req.Sorting != "" {
+		q.Set("sorting", req.Sorting)
+	}
+	u.RawQuery = q.Encode()
+
+	httpReq, err := newRequest(ctx, ps.tier, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return GetPaymentsByStatusResponse{}, err
+	}
+
+	var resp GetPaymentsByStatusResponse
+	err = do(ps.httpClient, httpReq, &resp)
+	if err != nil {
+		return GetPaymentsByStatusResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// CancelPaymentRequest represents a request for 'POST /payments/cancel'.
+// The payment is identified by ImpUID or, when empty, by MerchantUID.
+type CancelPaymentRequest struct {
+	ImpUID      string `json:"imp_uid,omitempty"`
+	MerchantUID string `json:"merchant_uid,omitempty"`
+	// Amount is the amount to cancel. The whole payment is cancelled when zero.
+	Amount    int64 `json:"amount,omitempty"`
+	TaxFree   int64 `json:"tax_free,omitempty"`
+	VatAmount int64 `json:"vat_amount,omitempty"`
+	// Checksum is the cancellable amount of the payment as known by the caller. The cancellation is
+	// rejected when it differs from the actual one, protecting against cancellations based on stale data.
+	Checksum *int64 `json:"checksum,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+	// The refund account is required to cancel virtual account payments.
+	RefundHolder  string `json:"refund_holder,omitempty"`
+	RefundBank    string `json:"refund_bank,omitempty"`
+	RefundAccount string `json:"refund_account,omitempty"`
+	RefundTel     string `json:"refund_tel,omitempty"`
+}
+
+// CancelPaymentResponse represents a response of 'POST /payments/cancel'.
+type CancelPaymentResponse struct {
+	CommonResponse
+	Response Payment `json:"response"`
+}
+
+// CancelPayment cancels a payment fully or partially.
+func (ps *paymentsService) CancelPayment(ctx context.Context, req CancelPaymentRequest) (CancelPaymentResponse, error) {
+	u := ps.baseURL.JoinPath("/cancel")
+	httpReq, err := newRequest(ctx, ps.tier, http.MethodPost, u.String(), req)
+	if err != nil {
+		return CancelPaymentResponse{}, err
+	}
+
+	var resp CancelPaymentResponse
+	err = do(ps.httpClient, httpReq, &resp)
+	if err != nil {
+		return CancelPaymentResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -2,6 +2,7 @@ package portone_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -153,75 +154,29 @@ func TestGetPayment(t *testing.T) {
 			Code:    0,
 			Message: "success",
 		},
-		Response: struct {
-			ImpUID            string                  `json:"imp_uid"`
-			MerchantUID       string                  `json:"merchant_uid"`
-			PayMethod         string                  `json:"pay_method"`
-			Channel           string                  `json:"channel"`
-			PgProvider        string                  `json:"pg_provider"`
-			EmbPgProvider     string                  `json:"emb_pg_provider"`
-			PgTid             string                  `json:"pg_tid"`
-			PgID              string                  `json:"pg_id"`
-			Escrow            bool                    `json:"escrow"`
-			ApplyNum          string                  `json:"apply_num"`
-			BankCode          string                  `json:"bank_code"`
-			BankName          string                  `json:"bank_name"`
-			CardCode          string                  `json:"card_code"`
-			CardName          string                  `json:"card_name"`
-			CardQuota         int                     `json:"card_quota"`
-			CardNumber        string                  `json:"card_number"`
-			CardType          int                     `json:"card_type"`
-			VbankCode         string                  `json:"vbank_code"`
-			VbankName         string                  `json:"vbank_name"`
-			VbankNum          string                  `json:"vbank_num"`
-			VbankHolder       string                  `json:"vbank_holder"`
-			VbankDate         int                     `json:"vbank_date"`
-			VbankIssuedAt     int                     `json:"vbank_issued_at"`
-			Name              string                  `json:"name"`
-			Amount            int                     `json:"amount"`
-			CancelAmount      int                     `json:"cancel_amount"`
-			Currency          string                  `json:"currency"`
-			BuyerName         string                  `json:"buyer_name"`
-			BuyerEmail        string                  `json:"buyer_email"`
-			BuyerTel          string                  `json:"buyer_tel"`
-			BuyerAddr         string                  `json:"buyer_addr"`
-			BuyerPostcode     string                  `json:"buyer_postcode"`
-			CustomData        string                  `json:"custom_data"`
-			UserAgent         string                  `json:"user_agent"`
-			Status            string                  `json:"status"`
-			StartedAt         int                     `json:"started_at"`
-			PaidAt            int                     `json:"paid_at"`
-			FailedAt          int                     `json:"failed_at"`
-			CancelledAt       int                     `json:"cancelled_at"`
-			FailReason        string                  `json:"fail_reason"`
-			CancelReason      string                  `json:"cancel_reason"`
-			ReceiptURL        string                  `json:"receipt_url"`
-			CancelHistory     []portone.CancelHistory `json:"cancel_history"`
-			CancelReceiptUrls []string                `json:"cancel_receipt_urls"`
-			CashReceiptIssued bool                    `json:"cash_receipt_issued"`
-			CustomerUID       string                  `json:"customer_uid"`
-			CustomerUIDUsage  string                  `json:"customer_uid_usage"`
-		}{
-			ImpUID:            "test_imp_uid",
-			MerchantUID:       "test_merchant_uid",
-			PayMethod:         "card",
-			Channel:           "pc",
-			PgProvider:        "nice",
-			PgTid:             "test_pg_tid",
-			PgID:              "test_pg_id",
-			Escrow:            false,
-			ApplyNum:          "test_apply_num",
-			BankCode:          "test_bank_code",
-			BankName:          "test_bank_name",
-			CardCode:          "test_card_code",
-			CardName:          "test_card_name",
-			CardQuota:         0,
-			CardType:          0,
-			VbankCode:         "test_vbank_code",
-			VbankName:         "test_vbank_name",
-			VbankNum:          "test_vbank_num",
-			VbankHolder:       "test_vbank_holder",
-			VbankDate:         0,
+		Response: portone.Payment{
+			ImpUID:      "test_imp_uid",
+			MerchantUID: "test_merchant_uid",
+			PayMethod:   "card",
+			Channel:     "pc",
+			PgProvider:  "nice",
+			PgTid:       "test_pg_tid",
+			PgID:        "test_pg_id",
+			Escrow:      false,
+			ApplyNum:    "test_apply_num",
+			BankCode:    "test_bank_code",
+			BankName:    "test_bank_name",
+			CardCode:    "test_card_code",
+			CardName:    "test_card_name",
+			CardQuota:   0,
+			CardType:    0,
+			Vbank: portone.Vbank{
+				VbankCode:   "test_vbank_code",
+				VbankName:   "test_vbank_name",
+				VbankNum:    "test_vbank_num",
+				VbankHolder: "test_vbank_holder",
+				VbankDate:   0,
+			},
 			Name:              "test_name",
 			Amount:            1000,
 			CancelAmount:      0,
@@ -252,3 +207,163 @@ func TestGetPayment(t *testing.T) {
 		t.Errorf("unexpected response (-want +got):\n%s", diff)
 	}
 }
+
+func TestCancelPayment(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/cancel", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"imp_uid":  "test_imp_uid",
+			"amount":   float64(500),
+			"checksum": float64(1000),
+			"reason":   "test_reason",
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"imp_uid": "test_imp_uid",
+				"merchant_uid": "test_merchant_uid",
+				"amount": 1000,
+				"cancel_amount": 500,
+				"status": "paid",
+				"cancel_history": [{"pg_tid": "test_pg_tid", "amount": 500, "cancelled_at": 1700000000, "reason": "test_reason"}]
+			}
+		}`))
+	})
+
+	checksum := int64(1000)
+	resp, err := client.CancelPayment(context.Background(), portone.CancelPaymentRequest{
+		ImpUID:   "test_imp_uid",
+		Amount:   500,
+		Checksum: &checksum,
+		Reason:   "test_reason",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Response.CancelAmount != 500 || len(resp.Response.CancelHistory) != 1 {
+		t.Errorf("unexpected payment: %+v", resp.Response)
+	}
+}
+
+func TestFindPayment(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/find/test_merchant_uid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"imp_uid": "test_imp_uid",
+				"merchant_uid": "test_merchant_uid",
+				"amount": 1000,
+				"status": "paid"
+			}
+		}`))
+	})
+
+	resp, err := client.FindPayment(context.Background(), "test_merchant_uid")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := portone.FindPaymentResponse{
+		CommonResponse: portone.CommonResponse{Code: 0, Message: "success"},
+		Response: portone.Payment{
+			ImpUID:      "test_imp_uid",
+			MerchantUID: "test_merchant_uid",
+			Amount:      1000,
+			Status:      "paid",
+		},
+	}
+	if diff := cmp.Diff(want, resp); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetPaymentsByStatus(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/status/paid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		want := "from=1700000000&limit=20&page=2&sorting=-paid&to=1700086400"
+		if r.URL.RawQuery != want {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"total": 41,
+				"previous": 1,
+				"next": 3,
+				"list": [
+					{
+						"imp_uid": "test_imp_uid",
+						"merchant_uid": "test_merchant_uid",
+						"amount": 1000,
+						"status": "paid"
+					}
+				]
+			}
+		}`))
+	})
+
+	resp, err := client.GetPaymentsByStatus(context.Background(), portone.GetPaymentsByStatusRequest{
+		Status:  "paid",
+		Page:    2,
+		Limit:   20,
+		From:    1700000000,
+		To:      1700086400,
+		Sorting: "-paid",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !resp.HasNextPage() {
+		t.Errorf("expected a next page")
+	}
+
+	want := []portone.Payment{{
+		ImpUID:      "test_imp_uid",
+		MerchantUID: "test_merchant_uid",
+		Amount:      1000,
+		Status:      "paid",
+	}}
+	if resp.Response.Total != 41 || resp.Response.Previous != 1 || resp.Response.Next != 3 {
+		t.Errorf("unexpected pagination: %+v", resp.Response)
+	}
+	if diff := cmp.Diff(want, resp.Response.List); diff != "" {
+		t.Errorf("unexpected payments (-want +got):\n%s", diff)
+	}
+}
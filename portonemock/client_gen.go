@@ -0,0 +1,411 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package portonemock
+
+import (
+	"context"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+// Client is a mock of portone.API.
+//
+// Each method records its call and delegates to the matching Func field.
+// Methods whose Func field is nil return zero values.
+type Client struct {
+	recorder
+
+	// portone.AuthAPI
+	GetTokenFunc func(ctx context.Context, req portone.GetTokenRequest) (portone.GetTokenResponse, error)
+
+	// portone.PaymentsAPI
+	CreatePaymentIntentFunc func(ctx context.Context, req portone.CreatePaymentIntentRequest) (portone.CreatePaymentIntentResponse, error)
+	GetPaymentFunc          func(ctx context.Context, paymentID string) (portone.GetPaymentResponse, error)
+	FindPaymentFunc         func(ctx context.Context, merchantUID string) (portone.FindPaymentResponse, error)
+	GetPaymentsByStatusFunc func(ctx context.Context, req portone.GetPaymentsByStatusRequest) (portone.GetPaymentsByStatusResponse, error)
+	CancelPaymentFunc       func(ctx context.Context, req portone.CancelPaymentRequest) (portone.CancelPaymentResponse, error)
+
+	// portone.VbanksAPI
+	IssueVbankFunc     func(ctx context.Context, req portone.IssueVbankRequest) (portone.IssueVbankResponse, error)
+	UpdateVbankFunc    func(ctx context.Context, req portone.UpdateVbankRequest) (portone.UpdateVbankResponse, error)
+	DeleteVbankFunc    func(ctx context.Context, impUID string) (portone.DeleteVbankResponse, error)
+	GetVbankHolderFunc func(ctx context.Context, req portone.GetVbankHolderRequest) (portone.GetVbankHolderResponse, error)
+
+	// portone.ReceiptsAPI
+	GetReceiptFunc            func(ctx context.Context, impUID string) (portone.ReceiptResponse, error)
+	IssueReceiptFunc          func(ctx context.Context, req portone.IssueReceiptRequest) (portone.ReceiptResponse, error)
+	CancelReceiptFunc         func(ctx context.Context, impUID string) (portone.ReceiptResponse, error)
+	GetExternalReceiptFunc    func(ctx context.Context, merchantUID string) (portone.ReceiptResponse, error)
+	IssueExternalReceiptFunc  func(ctx context.Context, req portone.IssueExternalReceiptRequest) (portone.ReceiptResponse, error)
+	CancelExternalReceiptFunc func(ctx context.Context, merchantUID string) (portone.ReceiptResponse, error)
+
+	// portone.EscrowsAPI
+	RegisterEscrowLogisFunc func(ctx context.Context, req portone.EscrowLogisRequest) (portone.EscrowLogisResponse, error)
+	UpdateEscrowLogisFunc   func(ctx context.Context, req portone.EscrowLogisRequest) (portone.EscrowLogisResponse, error)
+
+	// portone.CodesAPI
+	GetBanksFunc         func(ctx context.Context) (portone.GetBanksResponse, error)
+	GetBankFunc          func(ctx context.Context, code string) (portone.GetBankResponse, error)
+	GetCardsFunc         func(ctx context.Context) (portone.GetCardsResponse, error)
+	GetCardFunc          func(ctx context.Context, code string) (portone.GetCardResponse, error)
+	RefreshCodeTableFunc func(ctx context.Context, table *portone.CodeTable) error
+
+	// portone.NaverPayAPI
+	GetNaverProductOrdersFunc            func(ctx context.Context, impUID string) (portone.NaverProductOrdersResponse, error)
+	GetNaverProductOrderFunc             func(ctx context.Context, productOrderID string) (portone.NaverProductOrderResponse, error)
+	CancelNaverProductOrdersFunc         func(ctx context.Context, req portone.CancelNaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error)
+	ShipNaverProductOrdersFunc           func(ctx context.Context, req portone.ShipNaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error)
+	PlaceNaverProductOrdersFunc          func(ctx context.Context, req portone.NaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error)
+	ApproveCancelNaverProductOrdersFunc  func(ctx context.Context, req portone.NaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error)
+	ApproveReturnNaverProductOrdersFunc  func(ctx context.Context, req portone.NaverReturnRequest) (portone.NaverProductOrdersResponse, error)
+	RejectReturnNaverProductOrdersFunc   func(ctx context.Context, req portone.NaverReturnRequest) (portone.NaverProductOrdersResponse, error)
+	WithholdReturnNaverProductOrdersFunc func(ctx context.Context, req portone.WithholdReturnNaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error)
+	GetNaverReviewsFunc                  func(ctx context.Context, req portone.GetNaverReviewsRequest) (portone.GetNaverReviewsResponse, error)
+
+	// portone.KakaoPayAPI
+	GetKakaoPayOrdersFunc func(ctx context.Context, req portone.GetKakaoPayOrdersRequest) (portone.GetKakaoPayOrdersResponse, error)
+
+	// portone.PaycoAPI
+	UpdatePaycoOrderStatusFunc func(ctx context.Context, req portone.UpdatePaycoOrderStatusRequest) (portone.UpdatePaycoOrderStatusResponse, error)
+
+	// portone.TiersAPI
+	GetTierFunc func(ctx context.Context, tierCode string) (portone.GetTierResponse, error)
+
+	// portone.SubscribeAPI
+	PayAgainFunc func(ctx context.Context, req portone.PayAgainRequest) (portone.PayAgainResponse, error)
+}
+
+var _ portone.API = (*Client)(nil)
+
+// GetToken calls GetTokenFunc.
+func (c *Client) GetToken(ctx context.Context, req portone.GetTokenRequest) (portone.GetTokenResponse, error) {
+	c.record("GetToken", req)
+	if c.GetTokenFunc != nil {
+		return c.GetTokenFunc(ctx, req)
+	}
+	return portone.GetTokenResponse{}, nil
+}
+
+// CreatePaymentIntent calls CreatePaymentIntentFunc.
+func (c *Client) CreatePaymentIntent(ctx context.Context, req portone.CreatePaymentIntentRequest) (portone.CreatePaymentIntentResponse, error) {
+	c.record("CreatePaymentIntent", req)
+	if c.CreatePaymentIntentFunc != nil {
+		return c.CreatePaymentIntentFunc(ctx, req)
+	}
+	return portone.CreatePaymentIntentResponse{}, nil
+}
+
+// GetPayment calls GetPaymentFunc.
+func (c *Client) GetPayment(ctx context.Context, paymentID string) (portone.GetPaymentResponse, error) {
+	c.record("GetPayment", paymentID)
+	if c.GetPaymentFunc != nil {
+		return c.GetPaymentFunc(ctx, paymentID)
+	}
+	return portone.GetPaymentResponse{}, nil
+}
+
+// FindPayment calls FindPaymentFunc.
+func (c *Client) FindPayment(ctx context.Context, merchantUID string) (portone.FindPaymentResponse, error) {
+	c.record("FindPayment", merchantUID)
+	if c.FindPaymentFunc != nil {
+		return c.FindPaymentFunc(ctx, merchantUID)
+	}
+	return portone.FindPaymentResponse{}, nil
+}
+
+// GetPaymentsByStatus calls GetPaymentsByStatusFunc.
+func (c *Client) GetPaymentsByStatus(ctx context.Context, req portone.GetPaymentsByStatusRequest) (portone.GetPaymentsByStatusResponse, error) {
+	c.record("GetPaymentsByStatus", req)
+	if c.GetPaymentsByStatusFunc != nil {
+		return c.GetPaymentsByStatusFunc(ctx, req)
+	}
+	return portone.GetPaymentsByStatusResponse{}, nil
+}
+
+// CancelPayment calls CancelPaymentFunc.
+func (c *Client) CancelPayment(ctx context.Context, req portone.CancelPaymentRequest) (portone.CancelPaymentResponse, error) {
+	c.record("CancelPayment", req)
+	if c.CancelPaymentFunc != nil {
+		return c.CancelPaymentFunc(ctx, req)
+	}
+	return portone.CancelPaymentResponse{}, nil
+}
+
+// IssueVbank calls IssueVbankFunc.
+func (c *Client) IssueVbank(ctx context.Context, req portone.IssueVbankRequest) (portone.IssueVbankResponse, error) {
+	c.record("IssueVbank", req)
+	if c.IssueVbankFunc != nil {
+		return c.IssueVbankFunc(ctx, req)
+	}
+	return portone.IssueVbankResponse{}, nil
+}
+
+// UpdateVbank calls UpdateVbankFunc.
+func (c *Client) UpdateVbank(ctx context.Context, req portone.UpdateVbankRequest) (portone.UpdateVbankResponse, error) {
+	c.record("UpdateVbank", req)
+	if c.UpdateVbankFunc != nil {
+		return c.UpdateVbankFunc(ctx, req)
+	}
+	return portone.UpdateVbankResponse{}, nil
+}
+
+// DeleteVbank calls DeleteVbankFunc.
+func (c *Client) DeleteVbank(ctx context.Context, impUID string) (portone.DeleteVbankResponse, error) {
+	c.record("DeleteVbank", impUID)
+	if c.DeleteVbankFunc != nil {
+		return c.DeleteVbankFunc(ctx, impUID)
+	}
+	return portone.DeleteVbankResponse{}, nil
+}
+
+// GetVbankHolder calls GetVbankHolderFunc.
+func (c *Client) GetVbankHolder(ctx context.Context, req portone.GetVbankHolderRequest) (portone.GetVbankHolderResponse, error) {
+	c.record("GetVbankHolder", req)
+	if c.GetVbankHolderFunc != nil {
+		return c.GetVbankHolderFunc(ctx, req)
+	}
+	return portone.GetVbankHolderResponse{}, nil
+}
+
+// GetReceipt calls GetReceiptFunc.
+func (c *Client) GetReceipt(ctx context.Context, impUID string) (portone.ReceiptResponse, error) {
+	c.record("GetReceipt", impUID)
+	if c.GetReceiptFunc != nil {
+		return c.GetReceiptFunc(ctx, impUID)
+	}
+	return portone.ReceiptResponse{}, nil
+}
+
+// IssueReceipt calls IssueReceiptFunc.
+func (c *Client) IssueReceipt(ctx context.Context, req portone.IssueReceiptRequest) (portone.ReceiptResponse, error) {
+	c.record("IssueReceipt", req)
+	if c.IssueReceiptFunc != nil {
+		return c.IssueReceiptFunc(ctx, req)
+	}
+	return portone.ReceiptResponse{}, nil
+}
+
+// CancelReceipt calls CancelReceiptFunc.
+func (c *Client) CancelReceipt(ctx context.Context, impUID string) (portone.ReceiptResponse, error) {
+	c.record("CancelReceipt", impUID)
+	if c.CancelReceiptFunc != nil {
+		return c.CancelReceiptFunc(ctx, impUID)
+	}
+	return portone.ReceiptResponse{}, nil
+}
+
+// GetExternalReceipt calls GetExternalReceiptFunc.
+func (c *Client) GetExternalReceipt(ctx context.Context, merchantUID string) (portone.ReceiptResponse, error) {
+	c.record("GetExternalReceipt", merchantUID)
+	if c.GetExternalReceiptFunc != nil {
+		return c.GetExternalReceiptFunc(ctx, merchantUID)
+	}
+	return portone.ReceiptResponse{}, nil
+}
+
+// IssueExternalReceipt calls IssueExternalReceiptFunc.
+func (c *Client) IssueExternalReceipt(ctx context.Context, req portone.IssueExternalReceiptRequest) (portone.ReceiptResponse, error) {
+	c.record("IssueExternalReceipt", req)
+	if c.IssueExternalReceiptFunc != nil {
+		return c.IssueExternalReceiptFunc(ctx, req)
+	}
+	return portone.ReceiptResponse{}, nil
+}
+
+// CancelExternalReceipt calls CancelExternalReceiptFunc.
+func (c *Client) CancelExternalReceipt(ctx context.Context, merchantUID string) (portone.ReceiptResponse, error) {
+	c.record("CancelExternalReceipt", merchantUID)
+	if c.CancelExternalReceiptFunc != nil {
+		return c.CancelExternalReceiptFunc(ctx, merchantUID)
+	}
+	return portone.ReceiptResponse{}, nil
+}
+
+// RegisterEscrowLogis calls RegisterEscrowLogisFunc.
+func (c *Client) RegisterEscrowLogis(ctx context.Context, req portone.EscrowLogisRequest) (portone.EscrowLogisResponse, error) {
+	c.record("RegisterEscrowLogis", req)
+	if c.RegisterEscrowLogisFunc != nil {
+		return c.RegisterEscrowLogisFunc(ctx, req)
+	}
+	return portone.EscrowLogisResponse{}, nil
+}
+
+// UpdateEscrowLogis calls UpdateEscrowLogisFunc.
+func (c *Client) UpdateEscrowLogis(ctx context.Context, req portone.EscrowLogisRequest) (portone.EscrowLogisResponse, error) {
+	c.record("UpdateEscrowLogis", req)
+	if c.UpdateEscrowLogisFunc != nil {
+		return c.UpdateEscrowLogisFunc(ctx, req)
+	}
+	return portone.EscrowLogisResponse{}, nil
+}
+
+// GetBanks calls GetBanksFunc.
+func (c *Client) GetBanks(ctx context.Context) (portone.GetBanksResponse, error) {
+	c.record("GetBanks")
+	if c.GetBanksFunc != nil {
+		return c.GetBanksFunc(ctx)
+	}
+	return portone.GetBanksResponse{}, nil
+}
+
+// GetBank calls GetBankFunc.
+func (c *Client) GetBank(ctx context.Context, code string) (portone.GetBankResponse, error) {
+	c.record("GetBank", code)
+	if c.GetBankFunc != nil {
+		return c.GetBankFunc(ctx, code)
+	}
+	return portone.GetBankResponse{}, nil
+}
+
+// GetCards calls GetCardsFunc.
+func (c *Client) GetCards(ctx context.Context) (portone.GetCardsResponse, error) {
+	c.record("GetCards")
+	if c.GetCardsFunc != nil {
+		return c.GetCardsFunc(ctx)
+	}
+	return portone.GetCardsResponse{}, nil
+}
+
+// GetCard calls GetCardFunc.
+func (c *Client) GetCard(ctx context.Context, code string) (portone.GetCardResponse, error) {
+	c.record("GetCard", code)
+	if c.GetCardFunc != nil {
+		return c.GetCardFunc(ctx, code)
+	}
+	return portone.GetCardResponse{}, nil
+}
+
+// RefreshCodeTable calls RefreshCodeTableFunc.
+func (c *Client) RefreshCodeTable(ctx context.Context, table *portone.CodeTable) error {
+	c.record("RefreshCodeTable", table)
+	if c.RefreshCodeTableFunc != nil {
+		return c.RefreshCodeTableFunc(ctx, table)
+	}
+	return nil
+}
+
+// GetNaverProductOrders calls GetNaverProductOrdersFunc.
+func (c *Client) GetNaverProductOrders(ctx context.Context, impUID string) (portone.NaverProductOrdersResponse, error) {
+	c.record("GetNaverProductOrders", impUID)
+	if c.GetNaverProductOrdersFunc != nil {
+		return c.GetNaverProductOrdersFunc(ctx, impUID)
+	}
+	return portone.NaverProductOrdersResponse{}, nil
+}
+
+// GetNaverProductOrder calls GetNaverProductOrderFunc.
+func (c *Client) GetNaverProductOrder(ctx context.Context, productOrderID string) (portone.NaverProductOrderResponse, error) {
+	c.record("GetNaverProductOrder", productOrderID)
+	if c.GetNaverProductOrderFunc != nil {
+		return c.GetNaverProductOrderFunc(ctx, productOrderID)
+	}
+	return portone.NaverProductOrderResponse{}, nil
+}
+
+// CancelNaverProductOrders calls CancelNaverProductOrdersFunc.
+func (c *Client) CancelNaverProductOrders(ctx context.Context, req portone.CancelNaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error) {
+	c.record("CancelNaverProductOrders", req)
+	if c.CancelNaverProductOrdersFunc != nil {
+		return c.CancelNaverProductOrdersFunc(ctx, req)
+	}
+	return portone.NaverProductOrdersResponse{}, nil
+}
+
+// ShipNaverProductOrders calls ShipNaverProductOrdersFunc.
+func (c *Client) ShipNaverProductOrders(ctx context.Context, req portone.ShipNaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error) {
+	c.record("ShipNaverProductOrders", req)
+	if c.ShipNaverProductOrdersFunc != nil {
+		return c.ShipNaverProductOrdersFunc(ctx, req)
+	}
+	return portone.NaverProductOrdersResponse{}, nil
+}
+
+// PlaceNaverProductOrders calls PlaceNaverProductOrdersFunc.
+func (c *Client) PlaceNaverProductOrders(ctx context.Context, req portone.NaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error) {
+	c.record("PlaceNaverProductOrders", req)
+	if c.PlaceNaverProductOrdersFunc != nil {
+		return c.PlaceNaverProductOrdersFunc(ctx, req)
+	}
+	return portone.NaverProductOrdersResponse{}, nil
+}
+
+// ApproveCancelNaverProductOrders calls ApproveCancelNaverProductOrdersFunc.
+func (c *Client) ApproveCancelNaverProductOrders(ctx context.Context, req portone.NaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error) {
+	c.record("ApproveCancelNaverProductOrders", req)
+	if c.ApproveCancelNaverProductOrdersFunc != nil {
+		return c.ApproveCancelNaverProductOrdersFunc(ctx, req)
+	}
+	return portone.NaverProductOrdersResponse{}, nil
+}
+
+// ApproveReturnNaverProductOrders calls ApproveReturnNaverProductOrdersFunc.
+func (c *Client) ApproveReturnNaverProductOrders(ctx context.Context, req portone.NaverReturnRequest) (portone.NaverProductOrdersResponse, error) {
+	c.record("ApproveReturnNaverProductOrders", req)
+	if c.ApproveReturnNaverProductOrdersFunc != nil {
+		return c.ApproveReturnNaverProductOrdersFunc(ctx, req)
+	}
+	return portone.NaverProductOrdersResponse{}, nil
+}
+
+// RejectReturnNaverProductOrders calls RejectReturnNaverProductOrdersFunc.
+func (c *Client) RejectReturnNaverProductOrders(ctx context.Context, req portone.NaverReturnRequest) (portone.NaverProductOrdersResponse, error) {
+	c.record("RejectReturnNaverProductOrders", req)
+	if c.RejectReturnNaverProductOrdersFunc != nil {
+		return c.RejectReturnNaverProductOrdersFunc(ctx, req)
+	}
+	return portone.NaverProductOrdersResponse{}, nil
+}
+
+// WithholdReturnNaverProductOrders calls WithholdReturnNaverProductOrdersFunc.
+func (c *Client) WithholdReturnNaverProductOrders(ctx context.Context, req portone.WithholdReturnNaverProductOrdersRequest) (portone.NaverProductOrdersResponse, error) {
+	c.record("WithholdReturnNaverProductOrders", req)
+	if c.WithholdReturnNaverProductOrdersFunc != nil {
+		return c.WithholdReturnNaverProductOrdersFunc(ctx, req)
+	}
+	return portone.NaverProductOrdersResponse{}, nil
+}
+
+// GetNaverReviews calls GetNaverReviewsFunc.
+func (c *Client) GetNaverReviews(ctx context.Context, req portone.GetNaverReviewsRequest) (portone.GetNaverReviewsResponse, error) {
+	c.record("GetNaverReviews", req)
+	if c.GetNaverReviewsFunc != nil {
+		return c.GetNaverReviewsFunc(ctx, req)
+	}
+	return portone.GetNaverReviewsResponse{}, nil
+}
+
+// GetKakaoPayOrders calls GetKakaoPayOrdersFunc.
+func (c *Client) GetKakaoPayOrders(ctx context.Context, req portone.GetKakaoPayOrdersRequest) (portone.GetKakaoPayOrdersResponse, error) {
+	c.record("GetKakaoPayOrders", req)
+	if c.GetKakaoPayOrdersFunc != nil {
+		return c.GetKakaoPayOrdersFunc(ctx, req)
+	}
+	return portone.GetKakaoPayOrdersResponse{}, nil
+}
+
+// UpdatePaycoOrderStatus calls UpdatePaycoOrderStatusFunc.
+func (c *Client) UpdatePaycoOrderStatus(ctx context.Context, req portone.UpdatePaycoOrderStatusRequest) (portone.UpdatePaycoOrderStatusResponse, error) {
+	c.record("UpdatePaycoOrderStatus", req)
+	if c.UpdatePaycoOrderStatusFunc != nil {
+		return c.UpdatePaycoOrderStatusFunc(ctx, req)
+	}
+	return portone.UpdatePaycoOrderStatusResponse{}, nil
+}
+
+// GetTier calls GetTierFunc.
+func (c *Client) GetTier(ctx context.Context, tierCode string) (portone.GetTierResponse, error) {
+	c.record("GetTier", tierCode)
+	if c.GetTierFunc != nil {
+		return c.GetTierFunc(ctx, tierCode)
+	}
+	return portone.GetTierResponse{}, nil
+}
+
+// PayAgain calls PayAgainFunc.
+func (c *Client) PayAgain(ctx context.Context, req portone.PayAgainRequest) (portone.PayAgainResponse, error) {
+	c.record("PayAgain", req)
+	if c.PayAgainFunc != nil {
+		return c.PayAgainFunc(ctx, req)
+	}
+	return portone.PayAgainResponse{}, nil
+}
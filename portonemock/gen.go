@@ -0,0 +1,222 @@
+//go:build ignore
+
+// gen.go generates the mock client from the interfaces declared in api.go of the portone package.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	apiFile = "../api.go"
+	outFile = "client_gen.go"
+)
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type param struct {
+	name string
+	typ  string
+}
+
+type service struct {
+	name    string
+	methods []method
+}
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, apiFile, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var services []service
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		iface, ok := spec.Type.(*ast.InterfaceType)
+		if !ok {
+			return false
+		}
+
+		s := service{name: spec.Name.Name}
+		for _, field := range iface.Methods.List {
+			fn, ok := field.Type.(*ast.FuncType)
+			if !ok {
+				// Embedded interface, such as the ones of API.
+				continue
+			}
+			s.methods = append(s.methods, parseMethod(field.Names[0].Name, fn))
+		}
+		if len(s.methods) > 0 {
+			services = append(services, s)
+		}
+		return false
+	})
+
+	src, err := format.Source(generate(services))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = os.WriteFile(outFile, src, 0o644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseMethod(name string, fn *ast.FuncType) method {
+	m := method{name: name}
+	for _, field := range fn.Params.List {
+		for _, n := range field.Names {
+			m.params = append(m.params, param{name: n.Name, typ: qualify(field.Type)})
+		}
+	}
+	for _, field := range fn.Results.List {
+		m.results = append(m.results, qualify(field.Type))
+	}
+
+	return m
+}
+
+// qualify returns the source of a type expression with the exported identifiers of the portone package qualified.
+func qualify(expr ast.Expr) string {
+	return types.ExprString(qualifyExpr(expr))
+}
+
+func qualifyExpr(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			return &ast.SelectorExpr{X: ast.NewIdent("portone"), Sel: e}
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualifyExpr(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualifyExpr(e.Elt)}
+	case *ast.SelectorExpr:
+		return e
+	default:
+		log.Fatalf("unsupported type expression %T", expr)
+		return nil
+	}
+}
+
+func generate(services []service) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by gen.go; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package portonemock")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "import (")
+	fmt.Fprintln(&b, `"context"`)
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, `"github.com/connectfit-team/go-portone"`)
+	fmt.Fprintln(&b, ")")
+	fmt.Fprintln(&b)
+
+	fmt.Fprintln(&b, "// Client is a mock of portone.API.")
+	fmt.Fprintln(&b, "//")
+	fmt.Fprintln(&b, "// Each method records its call and delegates to the matching Func field.")
+	fmt.Fprintln(&b, "// Methods whose Func field is nil return zero values.")
+	fmt.Fprintln(&b, "type Client struct {")
+	fmt.Fprintln(&b, "recorder")
+	for _, s := range services {
+		fmt.Fprintln(&b)
+		fmt.Fprintf(&b, "// portone.%s\n", s.name)
+		for _, m := range s.methods {
+			fmt.Fprintf(&b, "%sFunc func%s\n", m.name, m.signature())
+		}
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var _ portone.API = (*Client)(nil)")
+
+	for _, s := range services {
+		for _, m := range s.methods {
+			fmt.Fprintln(&b)
+			fmt.Fprintf(&b, "// %s calls %sFunc.\n", m.name, m.name)
+			fmt.Fprintf(&b, "func (c *Client) %s%s {\n", m.name, m.signature())
+			fmt.Fprintf(&b, "c.record(%q%s)\n", m.name, m.recordedArgs())
+			fmt.Fprintf(&b, "if c.%sFunc != nil {\n", m.name)
+			fmt.Fprintf(&b, "return c.%sFunc(%s)\n", m.name, m.args())
+			fmt.Fprintln(&b, "}")
+			fmt.Fprintf(&b, "return %s\n", m.zeroResults())
+			fmt.Fprintln(&b, "}")
+		}
+	}
+
+	return b.Bytes()
+}
+
+func (m method) signature() string {
+	params := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		params = append(params, p.name+" "+p.typ)
+	}
+
+	results := strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		results = "(" + results + ")"
+	}
+
+	return "(" + strings.Join(params, ", ") + ") " + results
+}
+
+func (m method) args() string {
+	args := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		args = append(args, p.name)
+	}
+
+	return strings.Join(args, ", ")
+}
+
+// recordedArgs returns the arguments of the method but the context, prefixed with a comma.
+func (m method) recordedArgs() string {
+	var args string
+	for _, p := range m.params {
+		if p.typ == "context.Context" {
+			continue
+		}
+		args += ", " + p.name
+	}
+
+	return args
+}
+
+func (m method) zeroResults() string {
+	zeros := make([]string, 0, len(m.results))
+	for _, r := range m.results {
+		switch {
+		case r == "error", strings.HasPrefix(r, "*"), strings.HasPrefix(r, "[]"):
+			zeros = append(zeros, "nil")
+		case r == "string":
+			zeros = append(zeros, `""`)
+		case r == "bool":
+			zeros = append(zeros, "false")
+		default:
+			zeros = append(zeros, r+"{}")
+		}
+	}
+
+	return strings.Join(zeros, ", ")
+}
@@ -0,0 +1,68 @@
+// Package portonemock provides a mock of the PortOne V1 client, for testing code depending on
+// portone.API or on one of its smaller interfaces without an HTTP server.
+//
+//	client := &portonemock.Client{
+//		GetPaymentFunc: func(ctx context.Context, paymentID string) (portone.GetPaymentResponse, error) {
+//			return portone.GetPaymentResponse{Response: portone.Payment{ImpUID: paymentID, Status: "paid"}}, nil
+//		},
+//	}
+//	// ...
+//	calls := client.CallsTo("GetPayment")
+//
+// The Client is generated from the interfaces of the portone package. Run go generate after changing them.
+package portonemock
+
+import "sync"
+
+//go:generate go run gen.go
+
+// Call is a call made to the mock.
+type Call struct {
+	Method string
+	// Args are the arguments of the call, except the context.
+	Args []any
+}
+
+// recorder records the calls made to the mock. It is safe for concurrent use.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made to the mock, in order.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to a method of the mock, in order.
+func (r *recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
@@ -0,0 +1,64 @@
+package portonemock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/connectfit-team/go-portone/portonemock"
+	"github.com/google/go-cmp/cmp"
+)
+
+var _ portone.PaymentsAPI = (*portonemock.Client)(nil)
+
+func TestClient(t *testing.T) {
+	errCancel := errors.New("test error")
+	client := &portonemock.Client{
+		GetPaymentFunc: func(_ context.Context, paymentID string) (portone.GetPaymentResponse, error) {
+			return portone.GetPaymentResponse{Response: portone.Payment{ImpUID: paymentID, Status: "paid"}}, nil
+		},
+		CancelPaymentFunc: func(context.Context, portone.CancelPaymentRequest) (portone.CancelPaymentResponse, error) {
+			return portone.CancelPaymentResponse{}, errCancel
+		},
+	}
+
+	ctx := context.Background()
+
+	payment, err := client.GetPayment(ctx, "test_imp_uid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(portone.Payment{ImpUID: "test_imp_uid", Status: "paid"}, payment.Response); diff != "" {
+		t.Errorf("unexpected payment (-want +got):\n%s", diff)
+	}
+
+	cancelReq := portone.CancelPaymentRequest{ImpUID: "test_imp_uid", Reason: "test_reason"}
+	_, err = client.CancelPayment(ctx, cancelReq)
+	if !errors.Is(err, errCancel) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	tier, err := client.GetTier(ctx, "test_tier_code")
+	if err != nil || tier != (portone.GetTierResponse{}) {
+		t.Errorf("unexpected response of an unconfigured method: %+v, %v", tier, err)
+	}
+
+	wantCalls := []portonemock.Call{
+		{Method: "GetPayment", Args: []any{"test_imp_uid"}},
+		{Method: "CancelPayment", Args: []any{cancelReq}},
+		{Method: "GetTier", Args: []any{"test_tier_code"}},
+	}
+	if diff := cmp.Diff(wantCalls, client.Calls()); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(wantCalls[1:2], client.CallsTo("CancelPayment")); diff != "" {
+		t.Errorf("unexpected calls to CancelPayment (-want +got):\n%s", diff)
+	}
+
+	client.Reset()
+	if calls := client.Calls(); len(calls) != 0 {
+		t.Errorf("unexpected calls after reset: %+v", calls)
+	}
+}
@@ -0,0 +1,140 @@
+package portone
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type receiptsService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	tier       string
+}
+
+func newReceiptsService(baseURL *url.URL, httpClient *http.Client, tier string) *receiptsService {
+	return &receiptsService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		tier:       tier,
+	}
+}
+
+// ReceiptIdentifierType is the kind of identifier a cash receipt is issued to.
+type ReceiptIdentifierType string
+
+const (
+	ReceiptIdentifierTypePerson   ReceiptIdentifierType = "person"
+	ReceiptIdentifierTypePhone    ReceiptIdentifierType = "phone"
+	ReceiptIdentifierTypeBusiness ReceiptIdentifierType = "business"
+	ReceiptIdentifierTypeTaxCard  ReceiptIdentifierType = "taxcard"
+)
+
+// ReceiptType is the purpose of a cash receipt.
+type ReceiptType string
+
+const (
+	// ReceiptTypePersonal is a receipt for income deduction (소득공제용).
+	ReceiptTypePersonal ReceiptType = "person"
+	// ReceiptTypeCorporate is a receipt for expense proof (지출증빙용).
+	ReceiptTypeCorporate ReceiptType = "company"
+)
+
+// Receipt represents a cash receipt issued for a PortOne or an external transaction.
+type Receipt struct {
+	ImpUID      string      `json:"imp_uid"`
+	MerchantUID string      `json:"merchant_uid"`
+	ReceiptTid  string      `json:"receipt_tid"`
+	ApplyNum    string      `json:"apply_num"`
+	Type        ReceiptType `json:"type"`
+	Amount      int64       `json:"amount"`
+	Vat         int64       `json:"vat"`
+	ReceiptURL  string      `json:"receipt_url"`
+	AppliedAt   int64       `json:"applied_at"`
+	CancelledAt int64       `json:"cancelled_at"`
+}
+
+// ReceiptResponse represents a response of the '/receipts' endpoints.
+type ReceiptResponse struct {
+	CommonResponse
+	Response Receipt `json:"response"`
+}
+
+// GetReceipt returns the cash receipt issued for a PortOne transaction.
+func (rs *receiptsService) GetReceipt(ctx context.Context, impUID string) (ReceiptResponse, error) {
+	u := rs.baseURL.JoinPath(impUID)
+	return rs.doReceipt(ctx, http.MethodGet, u, nil)
+}
+
+// IssueReceiptRequest represents a request for 'POST /receipts/{imp_uid}'.
+type IssueReceiptRequest struct {
+	ImpUID         string                `json:"-"`
+	Identifier     string                `json:"identifier"`
+	IdentifierType ReceiptIdentifierType `json:"identifier_type,omitempty"`
+	Type           ReceiptType           `json:"type,omitempty"`
+	BuyerName      string                `json:"buyer_name,omitempty"`
+	BuyerEmail     string                `json:"buyer_email,omitempty"`
+	BuyerTel       string                `json:"buyer_tel,omitempty"`
+	TaxFree        int64                 `json:"tax_free,omitempty"`
+}
+
+// IssueReceipt issues a cash receipt for a PortOne transaction.
+func (rs *receiptsService) IssueReceipt(ctx context.Context, req IssueReceiptRequest) (ReceiptResponse, error) {
+	u := rs.baseURL.JoinPath(req.ImpUID)
+	return rs.doReceipt(ctx, http.MethodPost, u, req)
+}
+
+// CancelReceipt cancels the cash receipt issued for a PortOne transaction.
+func (rs *receiptsService) CancelReceipt(ctx context.Context, impUID string) (ReceiptResponse, error) {
+	u := rs.baseURL.JoinPath(impUID)
+	return rs.doReceipt(ctx, http.MethodDelete, u, nil)
+}
+
+// GetExternalReceipt returns the cash receipt issued for an external transaction.
+func (rs *receiptsService) GetExternalReceipt(ctx context.Context, merchantUID string) (ReceiptResponse, error) {
+	u := rs.baseURL.JoinPath("/external", merchantUID)
+	return rs.doReceipt(ctx, http.MethodGet, u, nil)
+}
+
+// IssueExternalReceiptRequest represents a request for 'POST /receipts/external/{merchant_uid}'.
+type IssueExternalReceiptRequest struct {
+	MerchantUID    string                `json:"-"`
+	Name           string                `json:"name"`
+	Amount         int64                 `json:"amount"`
+	Identifier     string                `json:"identifier"`
+	IdentifierType ReceiptIdentifierType `json:"identifier_type,omitempty"`
+	Type           ReceiptType           `json:"type,omitempty"`
+	Pg             string                `json:"pg,omitempty"`
+	BuyerName      string                `json:"buyer_name,omitempty"`
+	BuyerEmail     string                `json:"buyer_email,omitempty"`
+	BuyerTel       string                `json:"buyer_tel,omitempty"`
+	TaxFree        int64                 `json:"tax_free,omitempty"`
+	Vat            int64                 `json:"vat,omitempty"`
+}
+
+// IssueExternalReceipt issues a cash receipt for a transaction not processed by PortOne.
+func (rs *receiptsService) IssueExternalReceipt(ctx context.Context, req IssueExternalReceiptRequest) (ReceiptResponse, error) {
+	u := rs.baseURL.JoinPath("/external", req.MerchantUID)
+	return rs.doReceipt(ctx, http.MethodPost, u, req)
+}
+
+// CancelExternalReceipt cancels the cash receipt issued for an external transaction.
+func (rs *receiptsService) CancelExternalReceipt(ctx context.Context, merchantUID string) (ReceiptResponse, error) {
+	u := rs.baseURL.JoinPath("/external", merchantUID)
+	return rs.doReceipt(ctx, http.MethodDelete, u, nil)
+}
+
+func (rs *receiptsService) doReceipt(ctx context.Context, method string, u *url.URL, body any) (ReceiptResponse, error) {
+	httpReq, err := newRequest(ctx, rs.tier, method, u.String(), body)
+	if err != nil {
+		return ReceiptResponse{}, err
+	}
+
+	var resp ReceiptResponse
+	err = do(rs.httpClient, httpReq, &resp)
+	if err != nil {
+		return ReceiptResponse{}, err
+	}
+
+	return resp, nil
+}
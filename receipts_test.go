@@ -0,0 +1,121 @@
+package portone_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssueReceipt(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/receipts/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"identifier":      "01012345678",
+			"identifier_type": "phone",
+			"type":            "person",
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"imp_uid": "test_imp_uid",
+				"receipt_tid": "test_receipt_tid",
+				"apply_num": "test_apply_num",
+				"type": "person",
+				"amount": 1000,
+				"vat": 91,
+				"receipt_url": "test_receipt_url",
+				"applied_at": 1600000000,
+				"cancelled_at": 0
+			}
+		}`))
+	})
+
+	resp, err := client.IssueReceipt(context.Background(), portone.IssueReceiptRequest{
+		ImpUID:         "test_imp_uid",
+		Identifier:     "01012345678",
+		IdentifierType: portone.ReceiptIdentifierTypePhone,
+		Type:           portone.ReceiptTypePersonal,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := portone.ReceiptResponse{
+		CommonResponse: portone.CommonResponse{
+			Code:    0,
+			Message: "success",
+		},
+		Response: portone.Receipt{
+			ImpUID:     "test_imp_uid",
+			ReceiptTid: "test_receipt_tid",
+			ApplyNum:   "test_apply_num",
+			Type:       portone.ReceiptTypePersonal,
+			Amount:     1000,
+			Vat:        91,
+			ReceiptURL: "test_receipt_url",
+			AppliedAt:  1600_000_000,
+		},
+	}
+
+	if diff := cmp.Diff(want, resp); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
+
+func TestCancelExternalReceipt(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/receipts/external/test_merchant_uid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"merchant_uid": "test_merchant_uid",
+				"type": "company",
+				"cancelled_at": 1600000000
+			}
+		}`))
+	})
+
+	resp, err := client.CancelExternalReceipt(context.Background(), "test_merchant_uid")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := portone.Receipt{
+		MerchantUID: "test_merchant_uid",
+		Type:        portone.ReceiptTypeCorporate,
+		CancelledAt: 1600_000_000,
+	}
+
+	if diff := cmp.Diff(want, resp.Response); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
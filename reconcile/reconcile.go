@@ -0,0 +1,214 @@
+// Package reconcile compares the ledger of a merchant with the payments recorded by PortOne.
+//
+// The PortOne payments of a period are streamed through the status listing and matched by merchant_uid
+// with the entries of a caller supplied Ledger. Every discrepancy is reported as a Record of the Report:
+//
+//	report, err := reconcile.Reconcile(ctx, client, reconcile.SliceLedger(entries), from, to)
+//	if err != nil {
+//		return err
+//	}
+//	err = report.WriteCSV(w)
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+// PaymentLister lists PortOne payments. It is satisfied by *portone.Client.
+type PaymentLister interface {
+	GetPaymentsByStatus(ctx context.Context, req portone.GetPaymentsByStatusRequest) (portone.GetPaymentsByStatusResponse, error)
+}
+
+// Entry is a payment as recorded in the ledger of the merchant.
+type Entry struct {
+	MerchantUID string
+	// Amount is the amount charged for the order.
+	Amount int64
+	// RefundedAmount is the part of Amount which has been refunded.
+	RefundedAmount int64
+	// Status is the expected PortOne status of the payment: ready, paid, cancelled or failed.
+	// A partially refunded payment is paid. The status is not checked when empty.
+	Status string
+}
+
+// Ledger iterates over the entries of the ledger of the merchant.
+type Ledger interface {
+	// Next returns the next entry, or io.EOF once every entry has been returned.
+	Next(ctx context.Context) (Entry, error)
+}
+
+// SliceLedger is a Ledger over entries held in memory.
+func SliceLedger(entries []Entry) Ledger {
+	return &sliceLedger{entries: entries}
+}
+
+type sliceLedger struct {
+	entries []Entry
+}
+
+func (l *sliceLedger) Next(context.Context) (Entry, error) {
+	if len(l.entries) == 0 {
+		return Entry{}, io.EOF
+	}
+
+	e := l.entries[0]
+	l.entries = l.entries[1:]
+	return e, nil
+}
+
+// pageSize is the maximum number of payments PortOne returns per page.
+const pageSize = 100
+
+// Reconcile compares the ledger with the PortOne payments whose status changed between from and to.
+//
+// The ledger should hold the entries of the same period: an entry whose payment changed outside of it is reported
+// as missing. When several payments share a merchant_uid, for instance after failed attempts, the one which went
+// the furthest is compared. PortOne payments absent from the ledger are reported as extra unless they are ready or
+// failed, since abandoned attempts are not expected to be recorded.
+func Reconcile(ctx context.Context, payments PaymentLister, ledger Ledger, from, to time.Time) (*Report, error) {
+	byMerchantUID, err := listPayments(ctx, payments, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{From: from, To: to, Records: []Record{}}
+	seen := make(map[string]struct{})
+	for {
+		entry, err := ledger.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		seen[entry.MerchantUID] = struct{}{}
+
+		payment, ok := byMerchantUID[entry.MerchantUID]
+		if !ok {
+			report.Records = append(report.Records, Record{
+				Kind:         KindMissing,
+				MerchantUID:  entry.MerchantUID,
+				LedgerAmount: entry.Amount,
+				LedgerStatus: entry.Status,
+			})
+			continue
+		}
+
+		records := compare(entry, payment)
+		if len(records) == 0 {
+			report.Matched++
+		}
+		report.Records = append(report.Records, records...)
+	}
+
+	for merchantUID, payment := range byMerchantUID {
+		if _, ok := seen[merchantUID]; ok {
+			continue
+		}
+		if payment.Status == "ready" || payment.Status == "failed" {
+			continue
+		}
+
+		report.Records = append(report.Records, Record{
+			Kind:            KindExtra,
+			MerchantUID:     merchantUID,
+			ImpUID:          payment.ImpUID,
+			PortOneAmount:   int64(payment.Amount),
+			PortOneStatus:   payment.Status,
+			PortOneRefunded: int64(payment.CancelAmount),
+		})
+	}
+
+	sort.SliceStable(report.Records, func(i, j int) bool {
+		if report.Records[i].MerchantUID != report.Records[j].MerchantUID {
+			return report.Records[i].MerchantUID < report.Records[j].MerchantUID
+		}
+		return report.Records[i].Kind < report.Records[j].Kind
+	})
+
+	return report, nil
+}
+
+// listPayments streams the payments of the period, keeping the one which went the furthest per merchant_uid.
+func listPayments(ctx context.Context, payments PaymentLister, from, to time.Time) (map[string]portone.Payment, error) {
+	byMerchantUID := make(map[string]portone.Payment)
+
+	req := portone.GetPaymentsByStatusRequest{
+		Status: portone.PaymentStatusAll,
+		Page:   1,
+		Limit:  pageSize,
+		From:   from.Unix(),
+		To:     to.Unix(),
+	}
+	for {
+		resp, err := payments.GetPaymentsByStatus(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, p := range resp.Response.List {
+			current, ok := byMerchantUID[p.MerchantUID]
+			if !ok || supersedes(p, current) {
+				byMerchantUID[p.MerchantUID] = p
+			}
+		}
+
+		if !resp.HasNextPage() {
+			return byMerchantUID, nil
+		}
+		req.Page = resp.Response.Next
+	}
+}
+
+// statusRanks orders the statuses by how far a payment went.
+var statusRanks = map[string]int{
+	"failed":    1,
+	"ready":     2,
+	"cancelled": 3,
+	"paid":      4,
+}
+
+// supersedes reports whether p should be compared instead of current, which has the same merchant_uid.
+func supersedes(p, current portone.Payment) bool {
+	if statusRanks[p.Status] != statusRanks[current.Status] {
+		return statusRanks[p.Status] > statusRanks[current.Status]
+	}
+
+	return p.StartedAt > current.StartedAt
+}
+
+func compare(entry Entry, payment portone.Payment) []Record {
+	record := Record{
+		MerchantUID:     entry.MerchantUID,
+		ImpUID:          payment.ImpUID,
+		LedgerAmount:    entry.Amount,
+		PortOneAmount:   int64(payment.Amount),
+		LedgerStatus:    entry.Status,
+		PortOneStatus:   payment.Status,
+		LedgerRefunded:  entry.RefundedAmount,
+		PortOneRefunded: int64(payment.CancelAmount),
+	}
+
+	var records []Record
+	if record.LedgerAmount != record.PortOneAmount {
+		records = append(records, record.withKind(KindAmountMismatch))
+	}
+	if entry.Status != "" && entry.Status != payment.Status {
+		records = append(records, record.withKind(KindStatusMismatch))
+	}
+	if payment.Status == "paid" && record.LedgerRefunded != record.PortOneRefunded {
+		records = append(records, record.withKind(KindPartiallyRefunded))
+	}
+
+	return records
+}
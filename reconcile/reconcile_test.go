@@ -0,0 +1,164 @@
+package reconcile_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/connectfit-team/go-portone/portonemock"
+	"github.com/connectfit-team/go-portone/reconcile"
+	"github.com/google/go-cmp/cmp"
+)
+
+var _ reconcile.PaymentLister = (*portone.Client)(nil)
+
+// pagedPayments returns a mock listing the payments two by two.
+func pagedPayments(t *testing.T, from, to time.Time, payments ...portone.Payment) *portonemock.Client {
+	return &portonemock.Client{
+		GetPaymentsByStatusFunc: func(_ context.Context, req portone.GetPaymentsByStatusRequest) (portone.GetPaymentsByStatusResponse, error) {
+			if req.Status != portone.PaymentStatusAll || req.From != from.Unix() || req.To != to.Unix() {
+				t.Errorf("unexpected request: %+v", req)
+			}
+
+			var resp portone.GetPaymentsByStatusResponse
+			start := (req.Page - 1) * 2
+			end := min(start+2, len(payments))
+			resp.Response.Total = len(payments)
+			resp.Response.List = payments[start:end]
+			if end < len(payments) {
+				resp.Response.Next = req.Page + 1
+			}
+			return resp, nil
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	payments := pagedPayments(t, from, to,
+		portone.Payment{ImpUID: "imp_matched", MerchantUID: "order_matched", Amount: 1000, Status: "paid"},
+		portone.Payment{ImpUID: "imp_failed", MerchantUID: "order_retried", Amount: 2000, Status: "failed", StartedAt: 1},
+		portone.Payment{ImpUID: "imp_retried", MerchantUID: "order_retried", Amount: 2000, Status: "paid", StartedAt: 2},
+		portone.Payment{ImpUID: "imp_amount", MerchantUID: "order_amount", Amount: 1500, Status: "paid"},
+		portone.Payment{ImpUID: "imp_status", MerchantUID: "order_status", Amount: 1000, Status: "cancelled", CancelAmount: 1000},
+		portone.Payment{ImpUID: "imp_refunded", MerchantUID: "order_refunded", Amount: 1000, Status: "paid", CancelAmount: 300},
+		portone.Payment{ImpUID: "imp_unrefunded", MerchantUID: "order_unrefunded", Amount: 1000, Status: "paid"},
+		portone.Payment{ImpUID: "imp_extra", MerchantUID: "order_extra", Amount: 500, Status: "paid"},
+		portone.Payment{ImpUID: "imp_abandoned", MerchantUID: "order_abandoned", Amount: 500, Status: "failed"},
+	)
+
+	ledger := reconcile.SliceLedger([]reconcile.Entry{
+		{MerchantUID: "order_matched", Amount: 1000, Status: "paid"},
+		{MerchantUID: "order_retried", Amount: 2000, Status: "paid"},
+		{MerchantUID: "order_amount", Amount: 1000, Status: "paid"},
+		{MerchantUID: "order_status", Amount: 1000, Status: "paid"},
+		{MerchantUID: "order_refunded", Amount: 1000},
+		{MerchantUID: "order_unrefunded", Amount: 1000, RefundedAmount: 300, Status: "paid"},
+		{MerchantUID: "order_missing", Amount: 700, Status: "paid"},
+	})
+
+	report, err := reconcile.Reconcile(context.Background(), payments, ledger, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &reconcile.Report{
+		From:    from,
+		To:      to,
+		Matched: 2,
+		Records: []reconcile.Record{
+			{
+				Kind:          reconcile.KindAmountMismatch,
+				MerchantUID:   "order_amount",
+				ImpUID:        "imp_amount",
+				LedgerAmount:  1000,
+				PortOneAmount: 1500,
+				LedgerStatus:  "paid",
+				PortOneStatus: "paid",
+			},
+			{
+				Kind:          reconcile.KindExtra,
+				MerchantUID:   "order_extra",
+				ImpUID:        "imp_extra",
+				PortOneAmount: 500,
+				PortOneStatus: "paid",
+			},
+			{
+				Kind:         reconcile.KindMissing,
+				MerchantUID:  "order_missing",
+				LedgerAmount: 700,
+				LedgerStatus: "paid",
+			},
+			{
+				Kind:            reconcile.KindPartiallyRefunded,
+				MerchantUID:     "order_refunded",
+				ImpUID:          "imp_refunded",
+				LedgerAmount:    1000,
+				PortOneAmount:   1000,
+				PortOneStatus:   "paid",
+				PortOneRefunded: 300,
+			},
+			{
+				Kind:            reconcile.KindStatusMismatch,
+				MerchantUID:     "order_status",
+				ImpUID:          "imp_status",
+				LedgerAmount:    1000,
+				PortOneAmount:   1000,
+				LedgerStatus:    "paid",
+				PortOneStatus:   "cancelled",
+				PortOneRefunded: 1000,
+			},
+			{
+				Kind:           reconcile.KindPartiallyRefunded,
+				MerchantUID:    "order_unrefunded",
+				ImpUID:         "imp_unrefunded",
+				LedgerAmount:   1000,
+				PortOneAmount:  1000,
+				LedgerStatus:   "paid",
+				PortOneStatus:  "paid",
+				LedgerRefunded: 300,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, report); diff != "" {
+		t.Errorf("unexpected report (-want +got):\n%s", diff)
+	}
+
+	if calls := payments.CallsTo("GetPaymentsByStatus"); len(calls) != 5 {
+		t.Errorf("expected 5 pages to be fetched, got %d", len(calls))
+	}
+}
+
+type failingLedger struct{}
+
+func (failingLedger) Next(context.Context) (reconcile.Entry, error) {
+	return reconcile.Entry{}, io.ErrUnexpectedEOF
+}
+
+func TestReconcileErrors(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	_, err := reconcile.Reconcile(ctx, pagedPayments(t, from, to), failingLedger{}, from, to)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("unexpected error of the ledger: %v", err)
+	}
+
+	failing := &portonemock.Client{
+		GetPaymentsByStatusFunc: func(context.Context, portone.GetPaymentsByStatusRequest) (portone.GetPaymentsByStatusResponse, error) {
+			return portone.GetPaymentsByStatusResponse{CommonResponse: portone.CommonResponse{Code: -1, Message: "test_message"}}, nil
+		},
+	}
+	_, err = reconcile.Reconcile(ctx, failing, reconcile.SliceLedger(nil), from, to)
+
+	var portoneErr *portone.Error
+	if !errors.As(err, &portoneErr) {
+		t.Errorf("unexpected error of PortOne: %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package reconcile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Kind is the kind of discrepancy a Record reports.
+type Kind string
+
+const (
+	// KindMissing is an entry of the ledger without PortOne payment.
+	KindMissing Kind = "missing"
+	// KindExtra is a PortOne payment without entry in the ledger.
+	KindExtra Kind = "extra"
+	// KindAmountMismatch is a payment whose amount differs.
+	KindAmountMismatch Kind = "amount_mismatch"
+	// KindStatusMismatch is a payment whose status differs.
+	KindStatusMismatch Kind = "status_mismatch"
+	// KindPartiallyRefunded is a paid payment whose refunded amount is not the one of the ledger,
+	// including a refund recorded in the ledger but never issued at PortOne.
+	KindPartiallyRefunded Kind = "partially_refunded"
+)
+
+// Record is a discrepancy between the ledger and PortOne. A payment has one record per discrepancy.
+// The fields of the side the payment is missing from are zero.
+type Record struct {
+	Kind            Kind   `json:"kind"`
+	MerchantUID     string `json:"merchant_uid"`
+	ImpUID          string `json:"imp_uid,omitempty"`
+	LedgerAmount    int64  `json:"ledger_amount"`
+	PortOneAmount   int64  `json:"portone_amount"`
+	LedgerStatus    string `json:"ledger_status,omitempty"`
+	PortOneStatus   string `json:"portone_status,omitempty"`
+	LedgerRefunded  int64  `json:"ledger_refunded"`
+	PortOneRefunded int64  `json:"portone_refunded"`
+}
+
+func (r Record) withKind(kind Kind) Record {
+	r.Kind = kind
+	return r
+}
+
+// Report is the result of a reconciliation.
+type Report struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// Matched is the number of ledger entries agreeing with PortOne.
+	Matched int `json:"matched"`
+	// Records are sorted by merchant_uid.
+	Records []Record `json:"records"`
+}
+
+// OK reports whether the ledger agrees with PortOne.
+func (r *Report) OK() bool {
+	return len(r.Records) == 0
+}
+
+// Count returns the number of records of a kind.
+func (r *Report) Count(kind Kind) int {
+	var n int
+	for _, record := range r.Records {
+		if record.Kind == kind {
+			n++
+		}
+	}
+
+	return n
+}
+
+// WriteJSON writes the report as a JSON object.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+var csvHeader = []string{
+	"kind",
+	"merchant_uid",
+	"imp_uid",
+	"ledger_amount",
+	"portone_amount",
+	"ledger_status",
+	"portone_status",
+	"ledger_refunded",
+	"portone_refunded",
+}
+
+// WriteCSV writes the records of the report as CSV, with a header.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write(csvHeader)
+	for _, record := range r.Records {
+		_ = cw.Write([]string{
+			string(record.Kind),
+			record.MerchantUID,
+			record.ImpUID,
+			strconv.FormatInt(record.LedgerAmount, 10),
+			strconv.FormatInt(record.PortOneAmount, 10),
+			record.LedgerStatus,
+			record.PortOneStatus,
+			strconv.FormatInt(record.LedgerRefunded, 10),
+			strconv.FormatInt(record.PortOneRefunded, 10),
+		})
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
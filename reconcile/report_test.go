@@ -0,0 +1,74 @@
+package reconcile_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/connectfit-team/go-portone/reconcile"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newTestReport() *reconcile.Report {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return &reconcile.Report{
+		From:    from,
+		To:      from.AddDate(0, 0, 1),
+		Matched: 3,
+		Records: []reconcile.Record{
+			{Kind: reconcile.KindMissing, MerchantUID: "order_missing", LedgerAmount: 700, LedgerStatus: "paid"},
+			{Kind: reconcile.KindExtra, MerchantUID: "order_extra", ImpUID: "imp_extra", PortOneAmount: 500, PortOneStatus: "paid"},
+		},
+	}
+}
+
+func TestReportWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTestReport().WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "kind,merchant_uid,imp_uid,ledger_amount,portone_amount,ledger_status,portone_status,ledger_refunded,portone_refunded\n" +
+		"missing,order_missing,,700,0,paid,,0,0\n" +
+		"extra,order_extra,imp_extra,0,500,,paid,0,0\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("unexpected CSV (-want +got):\n%s", diff)
+	}
+}
+
+func TestReportWriteJSON(t *testing.T) {
+	report := newTestReport()
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var got reconcile.Report
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(report, &got); diff != "" {
+		t.Errorf("unexpected report after a round trip (-want +got):\n%s", diff)
+	}
+
+	if !bytes.Contains(buf.Bytes(), []byte(`"kind": "missing"`)) {
+		t.Errorf("unexpected JSON: %s", buf.String())
+	}
+}
+
+func TestReportCount(t *testing.T) {
+	report := newTestReport()
+
+	if report.OK() {
+		t.Errorf("expected discrepancies")
+	}
+	if n := report.Count(reconcile.KindMissing); n != 1 {
+		t.Errorf("unexpected count of missing records: %d", n)
+	}
+	if n := report.Count(reconcile.KindAmountMismatch); n != 0 {
+		t.Errorf("unexpected count of amount mismatches: %d", n)
+	}
+}
@@ -0,0 +1,65 @@
+package portone
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type subscribeService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	tier       string
+}
+
+func newSubscribeService(baseURL *url.URL, httpClient *http.Client, tier string) *subscribeService {
+	return &subscribeService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		tier:       tier,
+	}
+}
+
+// PayAgainRequest represents a request for 'POST /subscribe/payments/again'.
+type PayAgainRequest struct {
+	// CustomerUID is the billing key issued for the customer.
+	CustomerUID   string `json:"customer_uid"`
+	MerchantUID   string `json:"merchant_uid"`
+	Amount        int64  `json:"amount"`
+	TaxFree       int64  `json:"tax_free,omitempty"`
+	VatAmount     int64  `json:"vat_amount,omitempty"`
+	Currency      string `json:"currency,omitempty"`
+	Name          string `json:"name"`
+	BuyerName     string `json:"buyer_name,omitempty"`
+	BuyerEmail    string `json:"buyer_email,omitempty"`
+	BuyerTel      string `json:"buyer_tel,omitempty"`
+	BuyerAddr     string `json:"buyer_addr,omitempty"`
+	BuyerPostcode string `json:"buyer_postcode,omitempty"`
+	CardQuota     int    `json:"card_quota,omitempty"`
+	CustomData    string `json:"custom_data,omitempty"`
+	NoticeURL     string `json:"notice_url,omitempty"`
+}
+
+// PayAgainResponse represents a response of 'POST /subscribe/payments/again'.
+type PayAgainResponse struct {
+	CommonResponse
+	Response Payment `json:"response"`
+}
+
+// PayAgain charges the billing key of a customer (비인증 결제).
+// A declined charge is reported with a "failed" payment status rather than an error code.
+func (ss *subscribeService) PayAgain(ctx context.Context, req PayAgainRequest) (PayAgainResponse, error) {
+	u := ss.baseURL.JoinPath("/payments/again")
+	httpReq, err := newRequest(ctx, ss.tier, http.MethodPost, u.String(), req)
+	if err != nil {
+		return PayAgainResponse{}, err
+	}
+
+	var resp PayAgainResponse
+	err = do(ss.httpClient, httpReq, &resp)
+	if err != nil {
+		return PayAgainResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+package portone_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+func TestPayAgain(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/subscribe/payments/again", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body portone.PayAgainRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if body.CustomerUID != "test_customer_uid" || body.MerchantUID != "test_merchant_uid" || body.Amount != 9900 {
+			t.Errorf("unexpected body: %+v", body)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"imp_uid": "test_imp_uid",
+				"merchant_uid": "test_merchant_uid",
+				"customer_uid": "test_customer_uid",
+				"amount": 9900,
+				"status": "paid"
+			}
+		}`))
+	})
+
+	resp, err := client.PayAgain(context.Background(), portone.PayAgainRequest{
+		CustomerUID: "test_customer_uid",
+		MerchantUID: "test_merchant_uid",
+		Amount:      9900,
+		Name:        "test_name",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Response.ImpUID != "test_imp_uid" || resp.Response.Status != "paid" {
+		t.Errorf("unexpected payment: %+v", resp.Response)
+	}
+}
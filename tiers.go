@@ -0,0 +1,63 @@
+package portone
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+const tierHeader = "Tier"
+
+type tierContextKey struct{}
+
+// ContextWithTier returns a copy of ctx making the requests sent with it act on behalf of
+// the sub-merchant identified by tierCode. It takes precedence over WithTier: an empty tierCode
+// makes the requests act on behalf of the root merchant even if the client has a tier.
+func ContextWithTier(ctx context.Context, tierCode string) context.Context {
+	return context.WithValue(ctx, tierContextKey{}, tierCode)
+}
+
+func tierFromContext(ctx context.Context) (string, bool) {
+	tierCode, ok := ctx.Value(tierContextKey{}).(string)
+	return tierCode, ok
+}
+
+type tiersService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	tier       string
+}
+
+func newTiersService(baseURL *url.URL, httpClient *http.Client, tier string) *tiersService {
+	return &tiersService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		tier:       tier,
+	}
+}
+
+// GetTierResponse represents a response of 'GET /tiers/{tier_code}'.
+type GetTierResponse struct {
+	CommonResponse
+	Response struct {
+		TierCode string `json:"tier_code"`
+		TierName string `json:"tier_name"`
+	} `json:"response"`
+}
+
+// GetTier returns the sub-merchant identified by tierCode.
+func (ts *tiersService) GetTier(ctx context.Context, tierCode string) (GetTierResponse, error) {
+	u := ts.baseURL.JoinPath(tierCode)
+	httpReq, err := newRequest(ctx, ts.tier, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return GetTierResponse{}, err
+	}
+
+	var resp GetTierResponse
+	err = do(ts.httpClient, httpReq, &resp)
+	if err != nil {
+		return GetTierResponse{}, err
+	}
+
+	return resp, nil
+}
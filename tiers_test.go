@@ -0,0 +1,134 @@
+package portone_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+func TestGetTier(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/tiers/test_tier_code", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"tier_code": "test_tier_code",
+				"tier_name": "test_tier_name"
+			}
+		}`))
+	})
+
+	resp, err := client.GetTier(context.Background(), "test_tier_code")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Response.TierCode != "test_tier_code" || resp.Response.TierName != "test_tier_name" {
+		t.Errorf("unexpected response: %+v", resp.Response)
+	}
+}
+
+func TestTierHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []portone.ClientOption
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "no tier",
+			ctx:  context.Background(),
+			want: "",
+		},
+		{
+			name: "client tier",
+			opts: []portone.ClientOption{portone.WithTier("client_tier")},
+			ctx:  context.Background(),
+			want: "client_tier",
+		},
+		{
+			name: "context tier overrides client tier",
+			opts: []portone.ClientOption{portone.WithTier("client_tier")},
+			ctx:  portone.ContextWithTier(context.Background(), "context_tier"),
+			want: "context_tier",
+		},
+		{
+			name: "empty context tier clears client tier",
+			opts: []portone.ClientOption{portone.WithTier("client_tier")},
+			ctx:  portone.ContextWithTier(context.Background(), ""),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := mustInitClientWithAuthentication(t, tt.opts...)
+
+			var got string
+			mux.HandleFunc("/payments/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get("Tier")
+
+				w.Header().Set("Content-Type", contentTypeJSON)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"code": 0, "message": "success", "response": {}}`))
+			})
+
+			if _, err := client.GetPayment(tt.ctx, "test_imp_uid"); err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("unexpected tier header: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTierHeaderNotSentToGetToken(t *testing.T) {
+	client, mux := mustInitClient(t, portone.WithTier("client_tier"))
+
+	mux.HandleFunc("/users/getToken", func(w http.ResponseWriter, r *http.Request) {
+		if tier := r.Header.Get("Tier"); tier != "" {
+			t.Errorf("unexpected tier header: %s", tier)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {"access_token": "test_access_token", "now": 1600000000, "expired_at": 4102444800}
+		}`))
+	})
+
+	var got string
+	mux.HandleFunc("/payments/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Tier")
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"code": 0, "message": "success", "response": {}}`))
+	})
+
+	ctx := portone.ContextWithTier(context.Background(), "context_tier")
+	if _, err := client.GetPayment(ctx, "test_imp_uid"); err != nil {
+		t.Fatal(err)
+	}
+	if got != "context_tier" {
+		t.Errorf("unexpected tier header of the API call: %q", got)
+	}
+
+	if _, err := client.GetToken(ctx, portone.GetTokenRequest{RestAPIKey: testRestAPIKey, RestAPISecret: testRestAPISecret}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type authService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+}
+
+func newAuthService(baseURL *url.URL, httpClient *http.Client) *authService {
+	return &authService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+	}
+}
+
+// LoginRequest represents a request for 'POST /login/api-secret'.
+type LoginRequest struct {
+	APISecret string `json:"apiSecret"`
+}
+
+// LoginResponse represents a response of 'POST /login/api-secret'.
+type LoginResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// Login issues an access token and a refresh token for an API secret.
+func (as *authService) Login(ctx context.Context, req LoginRequest) (LoginResponse, error) {
+	u := as.baseURL.JoinPath("/login/api-secret")
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+
+	var resp LoginResponse
+	err = do(as.httpClient, httpReq, &resp)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// RefreshTokenRequest represents a request for 'POST /token/refresh'.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RefreshTokenResponse represents a response of 'POST /token/refresh'.
+type RefreshTokenResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RefreshToken issues a new access token from a refresh token.
+func (as *authService) RefreshToken(ctx context.Context, req RefreshTokenRequest) (RefreshTokenResponse, error) {
+	u := as.baseURL.JoinPath("/token/refresh")
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	if err != nil {
+		return RefreshTokenResponse{}, err
+	}
+
+	var resp RefreshTokenResponse
+	err = do(as.httpClient, httpReq, &resp)
+	if err != nil {
+		return RefreshTokenResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,102 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLogin(t *testing.T) {
+	client, mux := mustInitClient(t)
+
+	mux.HandleFunc("/login/api-secret", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.LoginRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if body.APISecret != testAPISecret {
+			t.Errorf("unexpected api secret: %s", body.APISecret)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"accessToken": "test_access_token", "refreshToken": "test_refresh_token"}`))
+	})
+
+	resp, err := client.Login(context.Background(), v2.LoginRequest{APISecret: testAPISecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.LoginResponse{
+		AccessToken:  "test_access_token",
+		RefreshToken: "test_refresh_token",
+	}
+
+	if diff := cmp.Diff(want, resp); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
+
+func TestLoginError(t *testing.T) {
+	client, mux := mustInitClient(t)
+
+	mux.HandleFunc("/login/api-secret", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"type": "UNAUTHORIZED", "message": "invalid api secret"}`))
+	})
+
+	_, err := client.Login(context.Background(), v2.LoginRequest{APISecret: "wrong"})
+
+	want := &v2.Error{
+		StatusCode: http.StatusUnauthorized,
+		Type:       v2.ErrorTypeUnauthorized,
+		Message:    "invalid api secret",
+	}
+
+	if diff := cmp.Diff(want, err); diff != "" {
+		t.Errorf("unexpected error (-want +got):\n%s", diff)
+	}
+
+	if !v2.IsErrorType(err, v2.ErrorTypeUnauthorized) {
+		t.Errorf("expected an unauthorized error, got %v", err)
+	}
+}
+
+func TestRefreshToken(t *testing.T) {
+	client, mux := mustInitClient(t)
+
+	mux.HandleFunc("/token/refresh", func(w http.ResponseWriter, r *http.Request) {
+		var body v2.RefreshTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if body.RefreshToken != "test_refresh_token" {
+			t.Errorf("unexpected refresh token: %s", body.RefreshToken)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"accessToken": "new_access_token", "refreshToken": "new_refresh_token"}`))
+	})
+
+	resp, err := client.RefreshToken(context.Background(), v2.RefreshTokenRequest{RefreshToken: "test_refresh_token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.AccessToken != "new_access_token" || resp.RefreshToken != "new_refresh_token" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
@@ -0,0 +1,282 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type billingKeysService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	storeID    string
+}
+
+func newBillingKeysService(baseURL *url.URL, httpClient *http.Client, storeID string) *billingKeysService {
+	return &billingKeysService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		storeID:    storeID,
+	}
+}
+
+// BillingKeyStatus is the status of a billing key.
+type BillingKeyStatus string
+
+const (
+	BillingKeyStatusIssued  BillingKeyStatus = "ISSUED"
+	BillingKeyStatusDeleted BillingKeyStatus = "DELETED"
+)
+
+// BillingKeyPaymentMethodType is the type of the method a billing key charges.
+type BillingKeyPaymentMethodType string
+
+const (
+	BillingKeyPaymentMethodTypeCard     BillingKeyPaymentMethodType = "BillingKeyPaymentMethodCard"
+	BillingKeyPaymentMethodTypeEasyPay  BillingKeyPaymentMethodType = "BillingKeyPaymentMethodEasyPay"
+	BillingKeyPaymentMethodTypeMobile   BillingKeyPaymentMethodType = "BillingKeyPaymentMethodMobile"
+	BillingKeyPaymentMethodTypeTransfer BillingKeyPaymentMethodType = "BillingKeyPaymentMethodTransfer"
+	BillingKeyPaymentMethodTypePaypal   BillingKeyPaymentMethodType = "BillingKeyPaymentMethodPaypal"
+)
+
+// BillingKeyPaymentMethod represents the method a billing key charges. Only the fields matching Type are set.
+type BillingKeyPaymentMethod struct {
+	Type BillingKeyPaymentMethodType `json:"type"`
+
+	// Card billing keys.
+	Card *Card `json:"card,omitempty"`
+
+	// Easy pay billing keys.
+	Provider      string                   `json:"provider,omitempty"`
+	EasyPayMethod *BillingKeyPaymentMethod `json:"method,omitempty"`
+
+	// Mobile billing keys.
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+
+	// Transfer billing keys.
+	Bank          Bank   `json:"bank,omitempty"`
+	AccountNumber string `json:"accountNumber,omitempty"`
+}
+
+// BillingKeyInfo represents a billing key.
+type BillingKeyInfo struct {
+	Status      BillingKeyStatus          `json:"status"`
+	BillingKey  string                    `json:"billingKey"`
+	MerchantID  string                    `json:"merchantId"`
+	StoreID     string                    `json:"storeId"`
+	Methods     []BillingKeyPaymentMethod `json:"methods,omitempty"`
+	Channels    []SelectedChannel         `json:"channels"`
+	Customer    Customer                  `json:"customer"`
+	CustomData  string                    `json:"customData,omitempty"`
+	IssueID     string                    `json:"issueId,omitempty"`
+	IssueName   string                    `json:"issueName,omitempty"`
+	RequestedAt *time.Time                `json:"requestedAt,omitempty"`
+	IssuedAt    time.Time                 `json:"issuedAt"`
+	DeletedAt   *time.Time                `json:"deletedAt,omitempty"`
+}
+
+// CardCredential holds the card data used to issue a billing key or to pay instantly.
+type CardCredential struct {
+	Number      string `json:"number"`
+	ExpiryYear  string `json:"expiryYear"`
+	ExpiryMonth string `json:"expiryMonth"`
+	// BirthOrBusinessRegistrationNumber is the birth date (YYMMDD) of the owner, or the business registration number of a corporate card.
+	BirthOrBusinessRegistrationNumber string `json:"birthOrBusinessRegistrationNumber,omitempty"`
+	PasswordTwoDigits                 string `json:"passwordTwoDigits,omitempty"`
+}
+
+// CardMethodInput represents a card given by its credential.
+type CardMethodInput struct {
+	Credential CardCredential `json:"credential"`
+}
+
+// InstantPaymentMethodInput represents the method of a billing key issuance or an instant payment.
+type InstantPaymentMethodInput struct {
+	Card *CardMethodInput `json:"card,omitempty"`
+}
+
+// IssueBillingKeyRequest represents a request for 'POST /billing-keys'.
+type IssueBillingKeyRequest struct {
+	StoreID        string                    `json:"storeId,omitempty"`
+	Method         InstantPaymentMethodInput `json:"method"`
+	ChannelKey     string                    `json:"channelKey,omitempty"`
+	ChannelGroupID string                    `json:"channelGroupId,omitempty"`
+	Customer       *Customer                 `json:"customer,omitempty"`
+	CustomData     string                    `json:"customData,omitempty"`
+	NoticeURLs     []string                  `json:"noticeUrls,omitempty"`
+	// Bypass holds PG specific parameters.
+	Bypass json.RawMessage `json:"bypass,omitempty"`
+}
+
+// ChannelSpecificFailure describes why a billing key could not be issued on one of the channels of a channel group.
+type ChannelSpecificFailure struct {
+	Type    string          `json:"type"`
+	Channel SelectedChannel `json:"channel"`
+	PgCode  string          `json:"pgCode,omitempty"`
+	Message string          `json:"message,omitempty"`
+}
+
+// IssueBillingKeyResponse represents a response of 'POST /billing-keys'.
+type IssueBillingKeyResponse struct {
+	BillingKeyInfo          BillingKeyInfo           `json:"billingKeyInfo"`
+	ChannelSpecificFailures []ChannelSpecificFailure `json:"channelSpecificFailures,omitempty"`
+}
+
+// IssueBillingKey issues a billing key from card credentials.
+func (bs *billingKeysService) IssueBillingKey(ctx context.Context, req IssueBillingKeyRequest) (IssueBillingKeyResponse, error) {
+	req.StoreID = orDefault(req.StoreID, bs.storeID)
+
+	u := bs.baseURL.JoinPath()
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	if err != nil {
+		return IssueBillingKeyResponse{}, err
+	}
+
+	var resp IssueBillingKeyResponse
+	err = do(bs.httpClient, httpReq, &resp)
+	if err != nil {
+		return IssueBillingKeyResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetBillingKeyRequest represents a request for 'GET /billing-keys/{billingKey}'.
+type GetBillingKeyRequest struct {
+	BillingKey string
+	// StoreID defaults to the store of the client.
+	StoreID string
+}
+
+// GetBillingKey returns a billing key.
+func (bs *billingKeysService) GetBillingKey(ctx context.Context, req GetBillingKeyRequest) (BillingKeyInfo, error) {
+	u := bs.baseURL.JoinPath(req.BillingKey)
+	setStoreIDQuery(u, orDefault(req.StoreID, bs.storeID))
+
+	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return BillingKeyInfo{}, err
+	}
+
+	var resp BillingKeyInfo
+	err = do(bs.httpClient, httpReq, &resp)
+	if err != nil {
+		return BillingKeyInfo{}, err
+	}
+
+	return resp, nil
+}
+
+// BillingKeyFilter restricts a billing key search. Zero fields are ignored.
+type BillingKeyFilter struct {
+	StoreID string `json:"storeId,omitempty"`
+	// TimeRangeField is the timestamp From and Until apply to: "REQUESTED_AT", "ISSUED_AT", "DELETED_AT" or "STATUS_TIMESTAMP".
+	TimeRangeField string             `json:"timeRangeField,omitempty"`
+	From           *time.Time         `json:"from,omitempty"`
+	Until          *time.Time         `json:"until,omitempty"`
+	Status         []BillingKeyStatus `json:"status,omitempty"`
+	ChannelKeys    []string           `json:"channelKeys,omitempty"`
+	CustomerID     string             `json:"customerId,omitempty"`
+	IsTest         *bool              `json:"isTest,omitempty"`
+}
+
+// SearchBillingKeysRequest represents a request for 'GET /billing-keys'.
+type SearchBillingKeysRequest struct {
+	Page   *PageInput        `json:"page,omitempty"`
+	Filter *BillingKeyFilter `json:"filter,omitempty"`
+}
+
+// SearchBillingKeysResponse represents a response of 'GET /billing-keys'.
+type SearchBillingKeysResponse struct {
+	Items []BillingKeyInfo `json:"items"`
+	Page  PageInfo         `json:"page"`
+}
+
+// SearchBillingKeys returns the billing keys matching the filter, one page at a time.
+func (bs *billingKeysService) SearchBillingKeys(ctx context.Context, req SearchBillingKeysRequest) (SearchBillingKeysResponse, error) {
+	filter := BillingKeyFilter{}
+	if req.Filter != nil {
+		filter = *req.Filter
+	}
+	filter.StoreID = orDefault(filter.StoreID, bs.storeID)
+	req.Filter = &filter
+
+	u := bs.baseURL.JoinPath()
+	if err := setRequestBodyQuery(u, req); err != nil {
+		return SearchBillingKeysResponse{}, err
+	}
+
+	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return SearchBillingKeysResponse{}, err
+	}
+
+	var resp SearchBillingKeysResponse
+	err = do(bs.httpClient, httpReq, &resp)
+	if err != nil {
+		return SearchBillingKeysResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// DeleteBillingKeyRequest represents a request for 'DELETE /billing-keys/{billingKey}'.
+type DeleteBillingKeyRequest struct {
+	BillingKey string
+	// StoreID defaults to the store of the client.
+	StoreID string
+	Reason  string
+}
+
+// DeleteBillingKeyResponse represents a response of 'DELETE /billing-keys/{billingKey}'.
+type DeleteBillingKeyResponse struct {
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// DeleteBillingKey deletes a billing key.
+func (bs *billingKeysService) DeleteBillingKey(ctx context.Context, req DeleteBillingKeyRequest) (DeleteBillingKeyResponse, error) {
+	u := bs.baseURL.JoinPath(req.BillingKey)
+	setStoreIDQuery(u, orDefault(req.StoreID, bs.storeID))
+	if req.Reason != "" {
+		q := u.Query()
+		q.Set("reason", req.Reason)
+		u.RawQuery = q.Encode()
+	}
+
+	httpReq, err := newRequest(ctx, http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return DeleteBillingKeyResponse{}, err
+	}
+
+	var resp DeleteBillingKeyResponse
+	err = do(bs.httpClient, httpReq, &resp)
+	if err != nil {
+		return DeleteBillingKeyResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// IssueBillingKeyAndPay issues a billing key and charges it right away, which is how the first payment
+// of a subscription is usually made. The BillingKey of payReq is set to the issued key, and the issued
+// key is returned even if the payment fails so it can be charged again or deleted.
+func (c *Client) IssueBillingKeyAndPay(ctx context.Context, issueReq IssueBillingKeyRequest, payReq PayWithBillingKeyRequest) (IssueBillingKeyResponse, PayWithBillingKeyResponse, error) {
+	issueResp, err := c.IssueBillingKey(ctx, issueReq)
+	if err != nil {
+		return IssueBillingKeyResponse{}, PayWithBillingKeyResponse{}, err
+	}
+
+	payReq.BillingKey = issueResp.BillingKeyInfo.BillingKey
+	if payReq.StoreID == "" {
+		payReq.StoreID = issueReq.StoreID
+	}
+
+	payResp, err := c.PayWithBillingKey(ctx, payReq)
+	if err != nil {
+		return issueResp, PayWithBillingKeyResponse{}, err
+	}
+
+	return issueResp, payResp, nil
+}
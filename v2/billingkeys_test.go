@@ -0,0 +1,222 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testBillingKeyInfo = `{
+	"status": "ISSUED",
+	"billingKey": "test_billing_key",
+	"merchantId": "merchant-test",
+	"storeId": "store-test",
+	"methods": [
+		{"type": "BillingKeyPaymentMethodCard", "card": {"publisher": "SHINHAN", "number": "1234-****-****-5678"}}
+	],
+	"channels": [
+		{"type": "TEST", "id": "test_channel_id", "key": "test_channel_key", "name": "test_channel", "pgProvider": "NICEPAY", "pgMerchantId": "test_pg_merchant_id"}
+	],
+	"customer": {"id": "test_customer_id"},
+	"issuedAt": "2024-01-01T00:00:00Z"
+}`
+
+func TestIssueBillingKey(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/billing-keys", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		checkAuthorization(t, r)
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"storeId":    testStoreID,
+			"channelKey": "test_channel_key",
+			"method": map[string]any{
+				"card": map[string]any{
+					"credential": map[string]any{
+						"number":      "1234567812345678",
+						"expiryYear":  "29",
+						"expiryMonth": "12",
+					},
+				},
+			},
+			"customer": map[string]any{"id": "test_customer_id"},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"billingKeyInfo": ` + testBillingKeyInfo + `}`))
+	})
+
+	resp, err := client.IssueBillingKey(context.Background(), v2.IssueBillingKeyRequest{
+		ChannelKey: "test_channel_key",
+		Method: v2.InstantPaymentMethodInput{
+			Card: &v2.CardMethodInput{
+				Credential: v2.CardCredential{
+					Number:      "1234567812345678",
+					ExpiryYear:  "29",
+					ExpiryMonth: "12",
+				},
+			},
+		},
+		Customer: &v2.Customer{ID: "test_customer_id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.BillingKeyInfo{
+		Status:     v2.BillingKeyStatusIssued,
+		BillingKey: "test_billing_key",
+		MerchantID: "merchant-test",
+		StoreID:    testStoreID,
+		Methods: []v2.BillingKeyPaymentMethod{
+			{
+				Type: v2.BillingKeyPaymentMethodTypeCard,
+				Card: &v2.Card{Publisher: "SHINHAN", Number: "1234-****-****-5678"},
+			},
+		},
+		Channels: []v2.SelectedChannel{
+			{
+				Type:         v2.ChannelTypeTest,
+				ID:           "test_channel_id",
+				Key:          "test_channel_key",
+				Name:         "test_channel",
+				PgProvider:   "NICEPAY",
+				PgMerchantID: "test_pg_merchant_id",
+			},
+		},
+		Customer: v2.Customer{ID: "test_customer_id"},
+		IssuedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	if diff := cmp.Diff(want, resp.BillingKeyInfo); diff != "" {
+		t.Errorf("unexpected billing key (-want +got):\n%s", diff)
+	}
+}
+
+func TestSearchBillingKeys(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/billing-keys", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.SearchBillingKeysRequest
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("requestBody")), &body); err != nil {
+			t.Errorf("unexpected request body: %v", err)
+		}
+
+		if body.Filter == nil || body.Filter.StoreID != testStoreID || body.Filter.CustomerID != "test_customer_id" {
+			t.Errorf("unexpected filter: %+v", body.Filter)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"items": [` + testBillingKeyInfo + `], "page": {"number": 0, "size": 10, "totalCount": 1}}`))
+	})
+
+	resp, err := client.SearchBillingKeys(context.Background(), v2.SearchBillingKeysRequest{
+		Filter: &v2.BillingKeyFilter{CustomerID: "test_customer_id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Items) != 1 || resp.Items[0].BillingKey != "test_billing_key" || resp.Page.HasNext() {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestDeleteBillingKey(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/billing-keys/test_billing_key", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		q := r.URL.Query()
+		if q.Get("storeId") != testStoreID || q.Get("reason") != "test_reason" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"deletedAt": "2024-01-02T00:00:00Z"}`))
+	})
+
+	resp, err := client.DeleteBillingKey(context.Background(), v2.DeleteBillingKeyRequest{
+		BillingKey: "test_billing_key",
+		Reason:     "test_reason",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !resp.DeletedAt.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected deletion time: %s", resp.DeletedAt)
+	}
+}
+
+func TestIssueBillingKeyAndPay(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/billing-keys", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"billingKeyInfo": ` + testBillingKeyInfo + `}`))
+	})
+
+	mux.HandleFunc("/payments/test_payment_id/billing-key", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.PayWithBillingKeyRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if body.BillingKey != "test_billing_key" || body.StoreID != testStoreID || body.Amount.Total != 9900 {
+			t.Errorf("unexpected body: %+v", body)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"payment": {"pgTxId": "test_pg_tx_id", "paidAt": "2024-01-01T00:00:01Z"}}`))
+	})
+
+	_, payResp, err := client.IssueBillingKeyAndPay(context.Background(), v2.IssueBillingKeyRequest{
+		ChannelKey: "test_channel_key",
+	}, v2.PayWithBillingKeyRequest{
+		PaymentID: "test_payment_id",
+		OrderName: "test_order_name",
+		Amount:    v2.PaymentAmountInput{Total: 9900},
+		Currency:  v2.CurrencyKRW,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if payResp.Payment.PgTxID != "test_pg_tx_id" {
+		t.Errorf("unexpected payment: %+v", payResp.Payment)
+	}
+}
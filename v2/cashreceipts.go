@@ -0,0 +1,176 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type cashReceiptsService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	storeID    string
+}
+
+func newCashReceiptsService(baseURL *url.URL, httpClient *http.Client, storeID string) *cashReceiptsService {
+	return &cashReceiptsService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		storeID:    storeID,
+	}
+}
+
+// CashReceiptType is the purpose of a cash receipt.
+type CashReceiptType string
+
+const (
+	// CashReceiptTypePersonal is a receipt for income deduction (소득공제용).
+	CashReceiptTypePersonal CashReceiptType = "PERSONAL"
+	// CashReceiptTypeCorporate is a receipt for expense proof (지출증빙용).
+	CashReceiptTypeCorporate CashReceiptType = "CORPORATE"
+	// CashReceiptTypeNoReceipt does not issue a receipt.
+	CashReceiptTypeNoReceipt CashReceiptType = "NO_RECEIPT"
+)
+
+// CashReceiptStatus is the status of a cash receipt.
+type CashReceiptStatus string
+
+const (
+	CashReceiptStatusIssued      CashReceiptStatus = "ISSUED"
+	CashReceiptStatusCancelled   CashReceiptStatus = "CANCELLED"
+	CashReceiptStatusIssueFailed CashReceiptStatus = "ISSUE_FAILED"
+)
+
+// PaymentProductType is the kind of goods a payment is made for.
+type PaymentProductType string
+
+const (
+	PaymentProductTypePhysical PaymentProductType = "PHYSICAL"
+	PaymentProductTypeDigital  PaymentProductType = "DIGITAL"
+)
+
+// CashReceipt represents a cash receipt issued for a payment.
+type CashReceipt struct {
+	Status        CashReceiptStatus `json:"status"`
+	PaymentID     string            `json:"paymentId"`
+	Channel       *SelectedChannel  `json:"channel,omitempty"`
+	Type          CashReceiptType   `json:"type,omitempty"`
+	OrderName     string            `json:"orderName"`
+	IsManual      bool              `json:"isManual"`
+	Currency      Currency          `json:"currency"`
+	TotalAmount   int64             `json:"totalAmount"`
+	TaxFreeAmount int64             `json:"taxFreeAmount"`
+	VatAmount     int64             `json:"vatAmount"`
+	PgReceiptID   string            `json:"pgReceiptId,omitempty"`
+	IssueNumber   string            `json:"issueNumber,omitempty"`
+	URL           string            `json:"url,omitempty"`
+	IssuedAt      *time.Time        `json:"issuedAt,omitempty"`
+	CancelledAt   *time.Time        `json:"cancelledAt,omitempty"`
+}
+
+// CashReceiptCustomer identifies the customer a cash receipt is issued to.
+type CashReceiptCustomer struct {
+	// IdentityNumber is a phone number, a business registration number or a cash receipt card number.
+	IdentityNumber string `json:"identityNumber"`
+	Name           string `json:"name,omitempty"`
+	Email          string `json:"email,omitempty"`
+	PhoneNumber    string `json:"phoneNumber,omitempty"`
+}
+
+// IssueCashReceiptRequest represents a request for 'POST /cash-receipts'.
+// It issues a receipt for a payment made outside of PortOne, identified by PaymentID.
+type IssueCashReceiptRequest struct {
+	StoreID     string              `json:"storeId,omitempty"`
+	PaymentID   string              `json:"paymentId"`
+	ChannelKey  string              `json:"channelKey"`
+	Type        CashReceiptType     `json:"type"`
+	OrderName   string              `json:"orderName"`
+	Currency    Currency            `json:"currency"`
+	Amount      PaymentAmountInput  `json:"amount"`
+	ProductType PaymentProductType  `json:"productType,omitempty"`
+	Customer    CashReceiptCustomer `json:"customer"`
+	PaidAt      *time.Time          `json:"paidAt,omitempty"`
+}
+
+// IssueCashReceiptResponse represents a response of 'POST /cash-receipts'.
+type IssueCashReceiptResponse struct {
+	CashReceipt CashReceipt `json:"cashReceipt"`
+}
+
+// IssueCashReceipt issues a cash receipt.
+func (cs *cashReceiptsService) IssueCashReceipt(ctx context.Context, req IssueCashReceiptRequest) (IssueCashReceiptResponse, error) {
+	req.StoreID = orDefault(req.StoreID, cs.storeID)
+
+	u := cs.baseURL.JoinPath("/cash-receipts")
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	if err != nil {
+		return IssueCashReceiptResponse{}, err
+	}
+
+	var resp IssueCashReceiptResponse
+	err = do(cs.httpClient, httpReq, &resp)
+	if err != nil {
+		return IssueCashReceiptResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetCashReceiptRequest represents a request for 'GET /payments/{paymentId}/cash-receipt'.
+type GetCashReceiptRequest struct {
+	PaymentID string
+	// StoreID defaults to the store of the client.
+	StoreID string
+}
+
+// GetCashReceipt returns the cash receipt issued for a payment.
+func (cs *cashReceiptsService) GetCashReceipt(ctx context.Context, req GetCashReceiptRequest) (CashReceipt, error) {
+	u := cs.baseURL.JoinPath("/payments", req.PaymentID, "/cash-receipt")
+	setStoreIDQuery(u, orDefault(req.StoreID, cs.storeID))
+
+	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return CashReceipt{}, err
+	}
+
+	var resp CashReceipt
+	err = do(cs.httpClient, httpReq, &resp)
+	if err != nil {
+		return CashReceipt{}, err
+	}
+
+	return resp, nil
+}
+
+// CancelCashReceiptRequest represents a request for 'POST /payments/{paymentId}/cash-receipt/cancel'.
+type CancelCashReceiptRequest struct {
+	PaymentID string
+	// StoreID defaults to the store of the client.
+	StoreID string
+}
+
+// CancelCashReceiptResponse represents a response of 'POST /payments/{paymentId}/cash-receipt/cancel'.
+type CancelCashReceiptResponse struct {
+	CancelledAmount int64     `json:"cancelledAmount"`
+	CancelledAt     time.Time `json:"cancelledAt"`
+}
+
+// CancelCashReceipt cancels the cash receipt issued with IssueCashReceipt for a payment.
+func (cs *cashReceiptsService) CancelCashReceipt(ctx context.Context, req CancelCashReceiptRequest) (CancelCashReceiptResponse, error) {
+	u := cs.baseURL.JoinPath("/payments", req.PaymentID, "/cash-receipt/cancel")
+	setStoreIDQuery(u, orDefault(req.StoreID, cs.storeID))
+
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), nil)
+	if err != nil {
+		return CancelCashReceiptResponse{}, err
+	}
+
+	var resp CancelCashReceiptResponse
+	err = do(cs.httpClient, httpReq, &resp)
+	if err != nil {
+		return CancelCashReceiptResponse{}, err
+	}
+
+	return resp, nil
+}
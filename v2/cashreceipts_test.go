@@ -0,0 +1,117 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssueCashReceipt(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/cash-receipts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"storeId":    testStoreID,
+			"paymentId":  "test_payment_id",
+			"channelKey": "test_channel_key",
+			"type":       "PERSONAL",
+			"orderName":  "test_order_name",
+			"currency":   "KRW",
+			"amount":     map[string]any{"total": float64(11000)},
+			"customer":   map[string]any{"identityNumber": "01012345678"},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"cashReceipt": {
+			"status": "ISSUED",
+			"paymentId": "test_payment_id",
+			"type": "PERSONAL",
+			"orderName": "test_order_name",
+			"isManual": true,
+			"currency": "KRW",
+			"totalAmount": 11000,
+			"taxFreeAmount": 0,
+			"vatAmount": 1000,
+			"issueNumber": "test_issue_number",
+			"issuedAt": "2024-01-01T00:00:00Z"
+		}}`))
+	})
+
+	resp, err := client.IssueCashReceipt(context.Background(), v2.IssueCashReceiptRequest{
+		PaymentID:  "test_payment_id",
+		ChannelKey: "test_channel_key",
+		Type:       v2.CashReceiptTypePersonal,
+		OrderName:  "test_order_name",
+		Currency:   v2.CurrencyKRW,
+		Amount:     v2.PaymentAmountInput{Total: 11000},
+		Customer:   v2.CashReceiptCustomer{IdentityNumber: "01012345678"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	want := v2.CashReceipt{
+		Status:      v2.CashReceiptStatusIssued,
+		PaymentID:   "test_payment_id",
+		Type:        v2.CashReceiptTypePersonal,
+		OrderName:   "test_order_name",
+		IsManual:    true,
+		Currency:    v2.CurrencyKRW,
+		TotalAmount: 11000,
+		VatAmount:   1000,
+		IssueNumber: "test_issue_number",
+		IssuedAt:    &issuedAt,
+	}
+	if diff := cmp.Diff(want, resp.CashReceipt); diff != "" {
+		t.Errorf("unexpected cash receipt (-want +got):\n%s", diff)
+	}
+}
+
+func TestCancelCashReceipt(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/test_payment_id/cash-receipt/cancel", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+		if got := r.URL.Query().Get("storeId"); got != testStoreID {
+			t.Errorf("unexpected store id: %s", got)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"cancelledAmount": 11000, "cancelledAt": "2024-01-02T00:00:00Z"}`))
+	})
+
+	resp, err := client.CancelCashReceipt(context.Background(), v2.CancelCashReceiptRequest{PaymentID: "test_payment_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.CancelCashReceiptResponse{
+		CancelledAmount: 11000,
+		CancelledAt:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	if diff := cmp.Diff(want, resp); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,286 @@
+// Package v2 is a client for the PortOne V2 REST API (https://api.portone.io).
+//
+// It is independent from the V1 client of the parent package so both can be used side by side
+// while migrating.
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBaseURL                   = "https://api.portone.io"
+	authServicePath                  = "/"
+	paymentsServicePath              = "/payments"
+	billingKeysServicePath           = "/billing-keys"
+	paymentSchedulesServicePath      = "/"
+	identityVerificationsServicePath = "/identity-verifications"
+	platformServicePath              = "/platform"
+	cashReceiptsServicePath          = "/"
+	b2bServicePath                   = "/b2b"
+)
+
+var (
+	defaultClientTimeout = 10 * time.Second
+	// defaultTokenLifetime is used when the expiry of an access token cannot be read from it.
+	defaultTokenLifetime = 25 * time.Minute
+)
+
+var defaultClientConfig = clientConfig{
+	baseURL: defaultBaseURL,
+	timeout: defaultClientTimeout,
+}
+
+type clientConfig struct {
+	baseURL string
+	timeout time.Duration
+	storeID string
+}
+
+type ClientOption func(*clientConfig)
+
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *clientConfig) {
+		c.baseURL = baseURL
+	}
+}
+
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.timeout = timeout
+	}
+}
+
+// WithStoreID sets the store the requests act on when the request does not specify one.
+// It is required when the API secret belongs to an agency or a platform managing several stores.
+func WithStoreID(storeID string) ClientOption {
+	return func(c *clientConfig) {
+		c.storeID = storeID
+	}
+}
+
+// Client is a client for PortOne V2 API.
+type Client struct {
+	clientConfig
+
+	*authService
+	*paymentsService
+	*billingKeysService
+	*paymentSchedulesService
+	*identityVerificationsService
+	*platformService
+	*cashReceiptsService
+	*b2bService
+}
+
+// NewClient returns a new PortOne V2 API client authenticating with the given API secret.
+func NewClient(apiSecret string, opts ...ClientOption) (*Client, error) {
+	cfg := defaultClientConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	u, err := url.Parse(cfg.baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	authServiceBaseURL := u.JoinPath(authServicePath)
+	authService := newAuthService(authServiceBaseURL, &http.Client{Timeout: cfg.timeout})
+
+	httpClient := &http.Client{
+		Timeout: cfg.timeout,
+		Transport: &roundTripperWithToken{
+			authService: authService,
+			apiSecret:   apiSecret,
+		},
+	}
+
+	paymentsServiceBaseURL := u.JoinPath(paymentsServicePath)
+	paymentsService := newPaymentsService(paymentsServiceBaseURL, httpClient, cfg.storeID)
+
+	billingKeysServiceBaseURL := u.JoinPath(billingKeysServicePath)
+	billingKeysService := newBillingKeysService(billingKeysServiceBaseURL, httpClient, cfg.storeID)
+
+	paymentSchedulesServiceBaseURL := u.JoinPath(paymentSchedulesServicePath)
+	paymentSchedulesService := newPaymentSchedulesService(paymentSchedulesServiceBaseURL, httpClient, cfg.storeID)
+
+	identityVerificationsServiceBaseURL := u.JoinPath(identityVerificationsServicePath)
+	identityVerificationsService := newIdentityVerificationsService(identityVerificationsServiceBaseURL, httpClient, cfg.storeID)
+
+	platformServiceBaseURL := u.JoinPath(platformServicePath)
+	platformService := newPlatformService(platformServiceBaseURL, httpClient)
+
+	cashReceiptsServiceBaseURL := u.JoinPath(cashReceiptsServicePath)
+	cashReceiptsService := newCashReceiptsService(cashReceiptsServiceBaseURL, httpClient, cfg.storeID)
+
+	b2bServiceBaseURL := u.JoinPath(b2bServicePath)
+	b2bService := newB2BService(b2bServiceBaseURL, httpClient)
+
+	return &Client{
+		clientConfig:                 cfg,
+		authService:                  authService,
+		paymentsService:              paymentsService,
+		billingKeysService:           billingKeysService,
+		paymentSchedulesService:      paymentSchedulesService,
+		identityVerificationsService: identityVerificationsService,
+		platformService:              platformService,
+		cashReceiptsService:          cashReceiptsService,
+		b2bService:                   b2bService,
+	}, nil
+}
+
+func newRequest(ctx context.Context, method, urlStr string, body any) (*http.Request, error) {
+	var buf io.ReadWriter
+	if body != nil {
+		buf = &bytes.Buffer{}
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return nil, err
+		}
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, urlStr, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	return httpReq, nil
+}
+
+// do sends req and decodes the response into respBody, or into an *Error if the API reports a failure.
+// respBody may be nil when the response has no meaningful body.
+func do(httpClient *http.Client, req *http.Request, respBody any) error {
+	httpResp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode >= http.StatusBadRequest {
+		return newError(httpResp)
+	}
+
+	if respBody == nil {
+		_, _ = io.Copy(io.Discard, httpResp.Body)
+		return nil
+	}
+
+	return json.NewDecoder(httpResp.Body).Decode(respBody)
+}
+
+type roundTripperWithToken struct {
+	authService *authService
+	apiSecret   string
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expireAt     time.Time
+}
+
+func (rt *roundTripperWithToken) RoundTrip(req *http.Request) (*http.Response, error) {
+	accessToken, err := rt.token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (rt *roundTripperWithToken) token(ctx context.Context) (string, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if rt.accessToken != "" && time.Now().Before(rt.expireAt) {
+		return rt.accessToken, nil
+	}
+
+	if rt.refreshToken != "" {
+		resp, err := rt.authService.RefreshToken(ctx, RefreshTokenRequest{RefreshToken: rt.refreshToken})
+		if err == nil {
+			rt.setToken(resp.AccessToken, resp.RefreshToken)
+			return rt.accessToken, nil
+		}
+		// The refresh token may have expired as well, fall back on logging in again.
+	}
+
+	resp, err := rt.authService.Login(ctx, LoginRequest{APISecret: rt.apiSecret})
+	if err != nil {
+		return "", err
+	}
+
+	rt.setToken(resp.AccessToken, resp.RefreshToken)
+	return rt.accessToken, nil
+}
+
+func (rt *roundTripperWithToken) setToken(accessToken, refreshToken string) {
+	rt.accessToken = accessToken
+	rt.refreshToken = refreshToken
+	rt.expireAt = tokenExpiry(accessToken)
+}
+
+// tokenExpiry returns when the given JWT access token expires, with a margin so it is not used
+// while expiring in flight.
+func tokenExpiry(accessToken string) time.Time {
+	const margin = time.Minute
+
+	parts := strings.Split(accessToken, ".")
+	if len(parts) == 3 {
+		payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+		if err == nil {
+			var claims struct {
+				Exp int64 `json:"exp"`
+			}
+			if json.Unmarshal(payload, &claims) == nil && claims.Exp > 0 {
+				return time.Unix(claims.Exp, 0).Add(-margin)
+			}
+		}
+	}
+
+	return time.Now().Add(defaultTokenLifetime - margin)
+}
+
+func orDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
+func setStoreIDQuery(u *url.URL, storeID string) {
+	if storeID == "" {
+		return
+	}
+
+	q := u.Query()
+	q.Set("storeId", storeID)
+	u.RawQuery = q.Encode()
+}
+
+// setRequestBodyQuery passes the body of a GET request through the 'requestBody' query parameter,
+// since bodies of GET requests are dropped by many proxies.
+func setRequestBodyQuery(u *url.URL, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	q := u.Query()
+	q.Set("requestBody", string(b))
+	u.RawQuery = q.Encode()
+	return nil
+}
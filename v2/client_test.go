@@ -0,0 +1,52 @@
+package v2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+)
+
+func TestTokenRefresh(t *testing.T) {
+	client, mux := mustInitClient(t)
+
+	var logins, refreshes atomic.Int32
+	mux.HandleFunc("/login/api-secret", func(w http.ResponseWriter, r *http.Request) {
+		logins.Add(1)
+
+		// The access token expires right away so the next request has to refresh it.
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"accessToken": %q, "refreshToken": "test_refresh_token"}`, testAccessToken(time.Now()))
+	})
+
+	mux.HandleFunc("/token/refresh", func(w http.ResponseWriter, r *http.Request) {
+		refreshes.Add(1)
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"accessToken": %q, "refreshToken": "test_refresh_token"}`, testAccessToken(time.Now().Add(time.Hour)))
+	})
+
+	mux.HandleFunc("/payments/test_payment_id", func(w http.ResponseWriter, r *http.Request) {
+		checkAuthorization(t, r)
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status": "READY", "id": "test_payment_id"}`))
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetPayment(context.Background(), v2.GetPaymentRequest{PaymentID: "test_payment_id"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if logins.Load() != 1 || refreshes.Load() != 1 {
+		t.Errorf("unexpected token requests: %d logins, %d refreshes", logins.Load(), refreshes.Load())
+	}
+}
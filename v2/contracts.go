@@ -0,0 +1,238 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// PlatformFeeType is the way the fee of the platform is computed.
+type PlatformFeeType string
+
+const (
+	PlatformFeeTypeFixedRate   PlatformFeeType = "FIXED_RATE"
+	PlatformFeeTypeFixedAmount PlatformFeeType = "FIXED_AMOUNT"
+)
+
+// PlatformFee is the fee the platform keeps on each settlement.
+type PlatformFee struct {
+	Type PlatformFeeType `json:"type"`
+	// Rate is expressed in thousandths of a percent (e.g. 1500 is 1.5%). It is set for fixed rate fees.
+	Rate int64 `json:"rate,omitempty"`
+	// Amount is set for fixed amount fees.
+	Amount int64 `json:"amount,omitempty"`
+}
+
+// PlatformFeeFixedRateInput is a fee proportional to the settled amount.
+type PlatformFeeFixedRateInput struct {
+	// Rate is expressed in thousandths of a percent.
+	Rate int64 `json:"rate"`
+}
+
+// PlatformFeeFixedAmountInput is a fee of a fixed amount per settlement.
+type PlatformFeeFixedAmountInput struct {
+	Amount int64 `json:"amount"`
+}
+
+// PlatformFeeInput selects the fee of a contract. Exactly one field must be set.
+type PlatformFeeInput struct {
+	FixedRate   *PlatformFeeFixedRateInput   `json:"fixedRate,omitempty"`
+	FixedAmount *PlatformFeeFixedAmountInput `json:"fixedAmount,omitempty"`
+}
+
+// PlatformFeeVatPayer is the party paying the VAT on the platform fee.
+type PlatformFeeVatPayer string
+
+const (
+	PlatformFeeVatPayerPartner  PlatformFeeVatPayer = "PARTNER"
+	PlatformFeeVatPayerPlatform PlatformFeeVatPayer = "PLATFORM"
+)
+
+// PlatformSettlementCycleDatePolicy decides how a settlement date falling on a holiday is moved.
+type PlatformSettlementCycleDatePolicy string
+
+const (
+	PlatformSettlementCycleDatePolicyHolidayBefore PlatformSettlementCycleDatePolicy = "HOLIDAY_BEFORE"
+	PlatformSettlementCycleDatePolicyHolidayAfter  PlatformSettlementCycleDatePolicy = "HOLIDAY_AFTER"
+	PlatformSettlementCycleDatePolicyCalendarDay   PlatformSettlementCycleDatePolicy = "CALENDAR_DAY"
+)
+
+// PlatformSettlementCycleMethodType is the recurrence of settlements.
+type PlatformSettlementCycleMethodType string
+
+const (
+	PlatformSettlementCycleMethodDaily       PlatformSettlementCycleMethodType = "DAILY"
+	PlatformSettlementCycleMethodWeekly      PlatformSettlementCycleMethodType = "WEEKLY"
+	PlatformSettlementCycleMethodMonthly     PlatformSettlementCycleMethodType = "MONTHLY"
+	PlatformSettlementCycleMethodManualDates PlatformSettlementCycleMethodType = "MANUAL_DATES"
+)
+
+// PlatformSettlementCycleMethod is the recurrence of settlements.
+// DaysOfWeek, DaysOfMonth and Dates are set for weekly, monthly and manual settlements respectively.
+type PlatformSettlementCycleMethod struct {
+	Type        PlatformSettlementCycleMethodType `json:"type"`
+	DaysOfWeek  []string                          `json:"daysOfWeek,omitempty"`
+	DaysOfMonth []int                             `json:"daysOfMonth,omitempty"`
+	// Dates are formatted as MM-DD.
+	Dates []string `json:"dates,omitempty"`
+}
+
+// PlatformSettlementCycle is the schedule settlements are transferred on.
+type PlatformSettlementCycle struct {
+	// LagDays is the number of days between the payment and its settlement.
+	LagDays    int                               `json:"lagDays"`
+	DatePolicy PlatformSettlementCycleDatePolicy `json:"datePolicy"`
+	Method     PlatformSettlementCycleMethod     `json:"method"`
+}
+
+// PlatformSettlementCycleMethodDailyInput settles every day.
+type PlatformSettlementCycleMethodDailyInput struct{}
+
+// PlatformSettlementCycleMethodWeeklyInput settles on the given days of the week (e.g. "MON").
+type PlatformSettlementCycleMethodWeeklyInput struct {
+	DaysOfWeek []string `json:"daysOfWeek"`
+}
+
+// PlatformSettlementCycleMethodMonthlyInput settles on the given days of the month.
+type PlatformSettlementCycleMethodMonthlyInput struct {
+	DaysOfMonth []int `json:"daysOfMonth"`
+}
+
+// PlatformSettlementCycleMethodManualDatesInput settles on the given dates of the year, formatted as MM-DD.
+type PlatformSettlementCycleMethodManualDatesInput struct {
+	Dates []string `json:"dates"`
+}
+
+// PlatformSettlementCycleMethodInput selects the recurrence of settlements. Exactly one field must be set.
+type PlatformSettlementCycleMethodInput struct {
+	Daily       *PlatformSettlementCycleMethodDailyInput       `json:"daily,omitempty"`
+	Weekly      *PlatformSettlementCycleMethodWeeklyInput      `json:"weekly,omitempty"`
+	Monthly     *PlatformSettlementCycleMethodMonthlyInput     `json:"monthly,omitempty"`
+	ManualDates *PlatformSettlementCycleMethodManualDatesInput `json:"manualDates,omitempty"`
+}
+
+// PlatformSettlementCycleInput is the schedule settlements of a contract are transferred on.
+type PlatformSettlementCycleInput struct {
+	LagDays    int                                `json:"lagDays"`
+	DatePolicy PlatformSettlementCycleDatePolicy  `json:"datePolicy"`
+	Method     PlatformSettlementCycleMethodInput `json:"method"`
+}
+
+// PlatformContract represents the settlement terms between the platform and its partners.
+type PlatformContract struct {
+	ID                       string                  `json:"id"`
+	Name                     string                  `json:"name"`
+	Memo                     string                  `json:"memo,omitempty"`
+	PlatformFee              PlatformFee             `json:"platformFee"`
+	SettlementCycle          PlatformSettlementCycle `json:"settlementCycle"`
+	PlatformFeeVatPayer      PlatformFeeVatPayer     `json:"platformFeeVatPayer"`
+	SubtractPaymentVatAmount bool                    `json:"subtractPaymentVatAmount"`
+	IsArchived               bool                    `json:"isArchived"`
+	AppliedAt                *time.Time              `json:"appliedAt,omitempty"`
+}
+
+// CreatePlatformContractRequest represents a request for 'POST /platform/contracts'.
+type CreatePlatformContractRequest struct {
+	// ID is generated by PortOne when empty.
+	ID                       string                       `json:"id,omitempty"`
+	Name                     string                       `json:"name"`
+	Memo                     string                       `json:"memo,omitempty"`
+	PlatformFee              PlatformFeeInput             `json:"platformFee"`
+	SettlementCycle          PlatformSettlementCycleInput `json:"settlementCycle"`
+	PlatformFeeVatPayer      PlatformFeeVatPayer          `json:"platformFeeVatPayer"`
+	SubtractPaymentVatAmount bool                         `json:"subtractPaymentVatAmount"`
+}
+
+// PlatformContractResponse represents a response of the endpoints returning a single contract.
+type PlatformContractResponse struct {
+	Contract PlatformContract `json:"contract"`
+}
+
+// CreatePlatformContract creates a contract.
+func (ps *platformService) CreatePlatformContract(ctx context.Context, req CreatePlatformContractRequest) (PlatformContractResponse, error) {
+	var resp PlatformContractResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath("/contracts"), req, &resp)
+	if err != nil {
+		return PlatformContractResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetPlatformContract returns a contract.
+func (ps *platformService) GetPlatformContract(ctx context.Context, contractID string) (PlatformContract, error) {
+	var resp PlatformContract
+	err := ps.send(ctx, http.MethodGet, ps.baseURL.JoinPath("/contracts", contractID), nil, &resp)
+	if err != nil {
+		return PlatformContract{}, err
+	}
+
+	return resp, nil
+}
+
+// PlatformContractFilter restricts a contract search. Zero fields are ignored.
+type PlatformContractFilter struct {
+	// IsArchived selects archived or active contracts. Both are returned when nil.
+	IsArchived        *bool                               `json:"isArchived,omitempty"`
+	PlatformFeePayers []PlatformFeeVatPayer               `json:"platformFeePayers,omitempty"`
+	CycleTypes        []PlatformSettlementCycleMethodType `json:"cycleTypes,omitempty"`
+	DatePolicies      []PlatformSettlementCycleDatePolicy `json:"datePolicies,omitempty"`
+	Keyword           string                              `json:"keyword,omitempty"`
+}
+
+// ListPlatformContractsRequest represents a request for 'GET /platform/contracts'.
+type ListPlatformContractsRequest struct {
+	Page   *PageInput              `json:"page,omitempty"`
+	Filter *PlatformContractFilter `json:"filter,omitempty"`
+}
+
+// ListPlatformContractsResponse represents a response of 'GET /platform/contracts'.
+type ListPlatformContractsResponse struct {
+	Items []PlatformContract `json:"items"`
+	Page  PageInfo           `json:"page"`
+}
+
+// ListPlatformContracts returns the contracts matching the filter, one page at a time.
+func (ps *platformService) ListPlatformContracts(ctx context.Context, req ListPlatformContractsRequest) (ListPlatformContractsResponse, error) {
+	var resp ListPlatformContractsResponse
+	err := ps.search(ctx, ps.baseURL.JoinPath("/contracts"), req, &resp)
+	if err != nil {
+		return ListPlatformContractsResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// UpdatePlatformContractRequest represents a request for 'PATCH /platform/contracts/{id}'.
+// Nil fields are left unchanged. The changes do not apply to the transfers already created.
+type UpdatePlatformContractRequest struct {
+	ID                       string                        `json:"-"`
+	Name                     *string                       `json:"name,omitempty"`
+	Memo                     *string                       `json:"memo,omitempty"`
+	PlatformFee              *PlatformFeeInput             `json:"platformFee,omitempty"`
+	SettlementCycle          *PlatformSettlementCycleInput `json:"settlementCycle,omitempty"`
+	PlatformFeeVatPayer      *PlatformFeeVatPayer          `json:"platformFeeVatPayer,omitempty"`
+	SubtractPaymentVatAmount *bool                         `json:"subtractPaymentVatAmount,omitempty"`
+}
+
+// UpdatePlatformContract updates a contract.
+func (ps *platformService) UpdatePlatformContract(ctx context.Context, req UpdatePlatformContractRequest) (PlatformContractResponse, error) {
+	var resp PlatformContractResponse
+	err := ps.send(ctx, http.MethodPatch, ps.baseURL.JoinPath("/contracts", req.ID), req, &resp)
+	if err != nil {
+		return PlatformContractResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// ArchivePlatformContract archives a contract. Archived contracts cannot be used by new partners or transfers.
+func (ps *platformService) ArchivePlatformContract(ctx context.Context, contractID string) (PlatformContractResponse, error) {
+	var resp PlatformContractResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath("/contracts", contractID, "/archive"), nil, &resp)
+	if err != nil {
+		return PlatformContractResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,106 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreatePlatformContract(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/contracts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"name":        "test_contract",
+			"platformFee": map[string]any{"fixedRate": map[string]any{"rate": float64(1500)}},
+			"settlementCycle": map[string]any{
+				"lagDays":    float64(3),
+				"datePolicy": "HOLIDAY_AFTER",
+				"method":     map[string]any{"weekly": map[string]any{"daysOfWeek": []any{"MON", "THU"}}},
+			},
+			"platformFeeVatPayer":      "PARTNER",
+			"subtractPaymentVatAmount": false,
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"contract": {
+			"id": "test_contract_id",
+			"name": "test_contract",
+			"platformFee": {"type": "FIXED_RATE", "rate": 1500},
+			"settlementCycle": {"lagDays": 3, "datePolicy": "HOLIDAY_AFTER", "method": {"type": "WEEKLY", "daysOfWeek": ["MON", "THU"]}},
+			"platformFeeVatPayer": "PARTNER",
+			"subtractPaymentVatAmount": false,
+			"isArchived": false
+		}}`))
+	})
+
+	resp, err := client.CreatePlatformContract(context.Background(), v2.CreatePlatformContractRequest{
+		Name:        "test_contract",
+		PlatformFee: v2.PlatformFeeInput{FixedRate: &v2.PlatformFeeFixedRateInput{Rate: 1500}},
+		SettlementCycle: v2.PlatformSettlementCycleInput{
+			LagDays:    3,
+			DatePolicy: v2.PlatformSettlementCycleDatePolicyHolidayAfter,
+			Method: v2.PlatformSettlementCycleMethodInput{
+				Weekly: &v2.PlatformSettlementCycleMethodWeeklyInput{DaysOfWeek: []string{"MON", "THU"}},
+			},
+		},
+		PlatformFeeVatPayer: v2.PlatformFeeVatPayerPartner,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.PlatformContract{
+		ID:          "test_contract_id",
+		Name:        "test_contract",
+		PlatformFee: v2.PlatformFee{Type: v2.PlatformFeeTypeFixedRate, Rate: 1500},
+		SettlementCycle: v2.PlatformSettlementCycle{
+			LagDays:    3,
+			DatePolicy: v2.PlatformSettlementCycleDatePolicyHolidayAfter,
+			Method: v2.PlatformSettlementCycleMethod{
+				Type:       v2.PlatformSettlementCycleMethodWeekly,
+				DaysOfWeek: []string{"MON", "THU"},
+			},
+		},
+		PlatformFeeVatPayer: v2.PlatformFeeVatPayerPartner,
+	}
+	if diff := cmp.Diff(want, resp.Contract); diff != "" {
+		t.Errorf("unexpected contract (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetPlatformContract(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/contracts/test_contract_id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"type": "PLATFORM_CONTRACT_NOT_FOUND", "message": "contract not found"}`))
+	})
+
+	_, err := client.GetPlatformContract(context.Background(), "test_contract_id")
+	if !v2.IsErrorType(err, v2.ErrorTypePlatformContractNotFound) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
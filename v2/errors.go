@@ -0,0 +1,61 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Error types reported by the API. The list is not exhaustive, each endpoint documents its own.
+const (
+	ErrorTypeInvalidRequest                 = "INVALID_REQUEST"
+	ErrorTypeUnauthorized                   = "UNAUTHORIZED"
+	ErrorTypeForbidden                      = "FORBIDDEN"
+	ErrorTypePgProvider                     = "PG_PROVIDER"
+	ErrorTypePaymentNotFound                = "PAYMENT_NOT_FOUND"
+	ErrorTypePaymentNotPaid                 = "PAYMENT_NOT_PAID"
+	ErrorTypePaymentAlreadyCancelled        = "PAYMENT_ALREADY_CANCELLED"
+	ErrorTypeCancellableAmountConsistency   = "CANCELLABLE_AMOUNT_CONSISTENCY_BROKEN"
+	ErrorTypeCancelAmountExceedsCancellable = "CANCEL_AMOUNT_EXCEEDS_CANCELLABLE_AMOUNT"
+	ErrorTypeAlreadyPaid                    = "ALREADY_PAID"
+	ErrorTypeBillingKeyNotFound             = "BILLING_KEY_NOT_FOUND"
+	ErrorTypeBillingKeyAlreadyDeleted       = "BILLING_KEY_ALREADY_DELETED"
+	ErrorTypeChannelNotFound                = "CHANNEL_NOT_FOUND"
+	ErrorTypePlatformPartnerNotFound        = "PLATFORM_PARTNER_NOT_FOUND"
+	ErrorTypePlatformContractNotFound       = "PLATFORM_CONTRACT_NOT_FOUND"
+	ErrorTypePlatformArchivedPartner        = "PLATFORM_ARCHIVED_PARTNER"
+	ErrorTypePlatformArchivedContract       = "PLATFORM_ARCHIVED_CONTRACT"
+	ErrorTypePlatformTransferNotFound       = "PLATFORM_TRANSFER_NOT_FOUND"
+	ErrorTypePlatformTransferNonDeletable   = "PLATFORM_TRANSFER_NON_DELETABLE_STATUS"
+)
+
+// Error represents a failure reported by the API.
+type Error struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int    `json:"-"`
+	Type       string `json:"type"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("portone v2: status=%d type=%s message=%s", e.StatusCode, e.Type, e.Message)
+}
+
+// IsErrorType reports whether err is an *Error of the given type.
+func IsErrorType(err error, typ string) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Type == typ
+}
+
+func newError(httpResp *http.Response) error {
+	e := &Error{StatusCode: httpResp.StatusCode}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil || json.Unmarshal(body, e) != nil || e.Type == "" {
+		e.Message = string(body)
+	}
+
+	return e
+}
@@ -0,0 +1,68 @@
+package v2_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+)
+
+const (
+	contentTypeJSON = "application/json"
+	testAPISecret   = "test_api_secret"
+	testStoreID     = "store-test"
+)
+
+func mustInitClient(t *testing.T, opts ...v2.ClientOption) (*v2.Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	client, err := v2.NewClient(testAPISecret, append([]v2.ClientOption{v2.WithBaseURL(srv.URL), v2.WithStoreID(testStoreID)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, mux
+}
+
+func mustInitClientWithAuthentication(t *testing.T, opts ...v2.ClientOption) (*v2.Client, *http.ServeMux) {
+	t.Helper()
+
+	client, mux := mustInitClient(t, opts...)
+
+	mux.HandleFunc("/login/api-secret", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"accessToken": %q, "refreshToken": "test_refresh_token"}`, testAccessToken(time.Now().Add(time.Hour)))
+	})
+
+	return client, mux
+}
+
+// testAccessToken returns an unsigned JWT expiring at the given time.
+func testAccessToken(expireAt time.Time) string {
+	enc := base64.RawURLEncoding
+	header := enc.EncodeToString([]byte(`{"alg":"none"}`))
+	payload := enc.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expireAt.Unix())))
+	return header + "." + payload + ".signature"
+}
+
+func checkAuthorization(t *testing.T, r *http.Request) {
+	t.Helper()
+
+	if got := r.Header.Get("Authorization"); !strings.HasPrefix(got, "Bearer ") {
+		t.Errorf("unexpected authorization: %s", got)
+	}
+}
@@ -0,0 +1,325 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type identityVerificationsService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	storeID    string
+}
+
+func newIdentityVerificationsService(baseURL *url.URL, httpClient *http.Client, storeID string) *identityVerificationsService {
+	return &identityVerificationsService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		storeID:    storeID,
+	}
+}
+
+// IdentityVerificationStatus is the status of an identity verification.
+type IdentityVerificationStatus string
+
+const (
+	IdentityVerificationStatusReady    IdentityVerificationStatus = "READY"
+	IdentityVerificationStatusVerified IdentityVerificationStatus = "VERIFIED"
+	IdentityVerificationStatusFailed   IdentityVerificationStatus = "FAILED"
+)
+
+// Carrier is a mobile carrier.
+type Carrier string
+
+const (
+	CarrierSKT     Carrier = "SKT"
+	CarrierKT      Carrier = "KT"
+	CarrierLGU     Carrier = "LGU"
+	CarrierSKTMVNO Carrier = "SKT_MVNO"
+	CarrierKTMVNO  Carrier = "KT_MVNO"
+	CarrierLGUMVNO Carrier = "LGU_MVNO"
+)
+
+// IdentityVerificationMethod is the way the OTP of an identity verification is sent.
+type IdentityVerificationMethod string
+
+const (
+	IdentityVerificationMethodSMS IdentityVerificationMethod = "SMS"
+	IdentityVerificationMethodApp IdentityVerificationMethod = "APP"
+)
+
+const birthDateLayout = "2006-01-02"
+
+// VerifiedCustomer represents the customer whose identity has been verified.
+type VerifiedCustomer struct {
+	ID          string
+	Name        string
+	Operator    Carrier
+	PhoneNumber string
+	// BirthDate is the birth date of the customer, at midnight UTC.
+	BirthDate   time.Time
+	Gender      Gender
+	IsForeigner bool
+	// CI (연계정보) identifies a person across services.
+	CI string
+	// DI (중복가입확인정보) identifies a person within a service.
+	DI string
+}
+
+type verifiedCustomerJSON struct {
+	ID          string  `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Operator    Carrier `json:"operator,omitempty"`
+	PhoneNumber string  `json:"phoneNumber,omitempty"`
+	BirthDate   string  `json:"birthDate"`
+	Gender      Gender  `json:"gender,omitempty"`
+	IsForeigner bool    `json:"isForeigner,omitempty"`
+	CI          string  `json:"ci,omitempty"`
+	DI          string  `json:"di,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c VerifiedCustomer) MarshalJSON() ([]byte, error) {
+	var birthDate string
+	if !c.BirthDate.IsZero() {
+		birthDate = c.BirthDate.Format(birthDateLayout)
+	}
+
+	return json.Marshal(verifiedCustomerJSON{
+		ID:          c.ID,
+		Name:        c.Name,
+		Operator:    c.Operator,
+		PhoneNumber: c.PhoneNumber,
+		BirthDate:   birthDate,
+		Gender:      c.Gender,
+		IsForeigner: c.IsForeigner,
+		CI:          c.CI,
+		DI:          c.DI,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *VerifiedCustomer) UnmarshalJSON(data []byte) error {
+	var raw verifiedCustomerJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var birthDate time.Time
+	if raw.BirthDate != "" {
+		var err error
+		birthDate, err = time.Parse(birthDateLayout, raw.BirthDate)
+		if err != nil {
+			return err
+		}
+	}
+
+	*c = VerifiedCustomer{
+		ID:          raw.ID,
+		Name:        raw.Name,
+		Operator:    raw.Operator,
+		PhoneNumber: raw.PhoneNumber,
+		BirthDate:   birthDate,
+		Gender:      raw.Gender,
+		IsForeigner: raw.IsForeigner,
+		CI:          raw.CI,
+		DI:          raw.DI,
+	}
+	return nil
+}
+
+// IdentityVerification is an identity verification in one of its states.
+// Use a type switch to access the fields specific to a state, as with Payment.
+type IdentityVerification interface {
+	// Common returns the fields shared by every state.
+	Common() *IdentityVerificationBase
+}
+
+// IdentityVerificationBase holds the fields shared by every identity verification state.
+type IdentityVerificationBase struct {
+	Status          IdentityVerificationStatus `json:"status"`
+	ID              string                     `json:"id"`
+	Channel         *SelectedChannel           `json:"channel,omitempty"`
+	CustomData      string                     `json:"customData,omitempty"`
+	RequestedAt     time.Time                  `json:"requestedAt"`
+	UpdatedAt       time.Time                  `json:"updatedAt"`
+	StatusChangedAt time.Time                  `json:"statusChangedAt"`
+}
+
+// Common implements IdentityVerification.
+func (b *IdentityVerificationBase) Common() *IdentityVerificationBase {
+	return b
+}
+
+// ReadyIdentityVerification is an identity verification which has been requested but not completed yet.
+type ReadyIdentityVerification struct {
+	IdentityVerificationBase
+	RequestedCustomer struct {
+		ID          string `json:"id,omitempty"`
+		Name        string `json:"name,omitempty"`
+		PhoneNumber string `json:"phoneNumber,omitempty"`
+	} `json:"requestedCustomer"`
+}
+
+// VerifiedIdentityVerification is a completed identity verification.
+type VerifiedIdentityVerification struct {
+	IdentityVerificationBase
+	VerifiedCustomer VerifiedCustomer `json:"verifiedCustomer"`
+	VerifiedAt       time.Time        `json:"verifiedAt"`
+	PgTxID           string           `json:"pgTxId"`
+	PgRawResponse    string           `json:"pgRawResponse,omitempty"`
+}
+
+// IdentityVerificationFailure describes why an identity verification failed.
+type IdentityVerificationFailure struct {
+	Reason    string `json:"reason"`
+	PgCode    string `json:"pgCode"`
+	PgMessage string `json:"pgMessage"`
+}
+
+// FailedIdentityVerification is an identity verification which failed.
+type FailedIdentityVerification struct {
+	IdentityVerificationBase
+	Failure IdentityVerificationFailure `json:"failure"`
+}
+
+// UnrecognizedIdentityVerification is an identity verification in a state unknown to this package.
+// Raw holds its JSON representation.
+type UnrecognizedIdentityVerification struct {
+	IdentityVerificationBase
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalIdentityVerification decodes an identity verification into the type matching its status.
+func UnmarshalIdentityVerification(data []byte) (IdentityVerification, error) {
+	var probe struct {
+		Status IdentityVerificationStatus `json:"status"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, err
+	}
+
+	var iv IdentityVerification
+	switch probe.Status {
+	case IdentityVerificationStatusReady:
+		iv = &ReadyIdentityVerification{}
+	case IdentityVerificationStatusVerified:
+		iv = &VerifiedIdentityVerification{}
+	case IdentityVerificationStatusFailed:
+		iv = &FailedIdentityVerification{}
+	default:
+		iv = &UnrecognizedIdentityVerification{Raw: append(json.RawMessage(nil), data...)}
+	}
+
+	if err := json.Unmarshal(data, iv); err != nil {
+		return nil, err
+	}
+
+	return iv, nil
+}
+
+// GetIdentityVerificationRequest represents a request for 'GET /identity-verifications/{identityVerificationId}'.
+type GetIdentityVerificationRequest struct {
+	IdentityVerificationID string
+	// StoreID defaults to the store of the client.
+	StoreID string
+}
+
+// GetIdentityVerification returns an identity verification.
+func (is *identityVerificationsService) GetIdentityVerification(ctx context.Context, req GetIdentityVerificationRequest) (IdentityVerification, error) {
+	u := is.baseURL.JoinPath(req.IdentityVerificationID)
+	setStoreIDQuery(u, orDefault(req.StoreID, is.storeID))
+
+	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw json.RawMessage
+	err = do(is.httpClient, httpReq, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return UnmarshalIdentityVerification(raw)
+}
+
+// IdentityVerificationCustomer represents the customer an identity verification OTP is sent to.
+type IdentityVerificationCustomer struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	PhoneNumber string `json:"phoneNumber"`
+	// IdentityNumber is the first seven digits of the resident registration number (YYMMDD + 1 digit).
+	IdentityNumber string `json:"identityNumber,omitempty"`
+	IPAddress      string `json:"ipAddress"`
+}
+
+// SendIdentityVerificationRequest represents a request for 'POST /identity-verifications/{identityVerificationId}/send'.
+type SendIdentityVerificationRequest struct {
+	IdentityVerificationID string                       `json:"-"`
+	StoreID                string                       `json:"storeId,omitempty"`
+	ChannelKey             string                       `json:"channelKey"`
+	Customer               IdentityVerificationCustomer `json:"customer"`
+	CustomData             string                       `json:"customData,omitempty"`
+	Operator               Carrier                      `json:"operator"`
+	Method                 IdentityVerificationMethod   `json:"method"`
+	// Bypass holds PG specific parameters.
+	Bypass json.RawMessage `json:"bypass,omitempty"`
+}
+
+// SendIdentityVerification starts an identity verification by sending an OTP to the customer.
+func (is *identityVerificationsService) SendIdentityVerification(ctx context.Context, req SendIdentityVerificationRequest) error {
+	req.StoreID = orDefault(req.StoreID, is.storeID)
+	return is.post(ctx, req.IdentityVerificationID, "/send", req, nil)
+}
+
+// ConfirmIdentityVerificationRequest represents a request for 'POST /identity-verifications/{identityVerificationId}/confirm'.
+type ConfirmIdentityVerificationRequest struct {
+	IdentityVerificationID string `json:"-"`
+	StoreID                string `json:"storeId,omitempty"`
+	// OTP is the code received by the customer. It may be empty for the methods not relying on one.
+	OTP string `json:"otp,omitempty"`
+}
+
+// ConfirmIdentityVerificationResponse represents a response of 'POST /identity-verifications/{identityVerificationId}/confirm'.
+type ConfirmIdentityVerificationResponse struct {
+	IdentityVerification VerifiedIdentityVerification `json:"identityVerification"`
+}
+
+// ConfirmIdentityVerification completes an identity verification with the OTP received by the customer.
+func (is *identityVerificationsService) ConfirmIdentityVerification(ctx context.Context, req ConfirmIdentityVerificationRequest) (ConfirmIdentityVerificationResponse, error) {
+	req.StoreID = orDefault(req.StoreID, is.storeID)
+
+	var resp ConfirmIdentityVerificationResponse
+	err := is.post(ctx, req.IdentityVerificationID, "/confirm", req, &resp)
+	if err != nil {
+		return ConfirmIdentityVerificationResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// ResendIdentityVerificationRequest represents a request for 'POST /identity-verifications/{identityVerificationId}/resend'.
+type ResendIdentityVerificationRequest struct {
+	IdentityVerificationID string `json:"-"`
+	StoreID                string `json:"storeId,omitempty"`
+}
+
+// ResendIdentityVerification sends the OTP of an identity verification again.
+func (is *identityVerificationsService) ResendIdentityVerification(ctx context.Context, req ResendIdentityVerificationRequest) error {
+	req.StoreID = orDefault(req.StoreID, is.storeID)
+	return is.post(ctx, req.IdentityVerificationID, "/resend", req, nil)
+}
+
+func (is *identityVerificationsService) post(ctx context.Context, id, action string, body, respBody any) error {
+	u := is.baseURL.JoinPath(id, action)
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), body)
+	if err != nil {
+		return err
+	}
+
+	return do(is.httpClient, httpReq, respBody)
+}
@@ -0,0 +1,213 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetIdentityVerification(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/identity-verifications/test_identity_verification_id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+		if got := r.URL.Query().Get("storeId"); got != testStoreID {
+			t.Errorf("unexpected store id: %s", got)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"status": "VERIFIED",
+			"id": "test_identity_verification_id",
+			"requestedAt": "2024-01-01T00:00:00Z",
+			"updatedAt": "2024-01-01T00:01:00Z",
+			"statusChangedAt": "2024-01-01T00:01:00Z",
+			"verifiedCustomer": {
+				"name": "홍길동",
+				"operator": "SKT",
+				"phoneNumber": "01012345678",
+				"birthDate": "1990-05-17",
+				"gender": "MALE",
+				"isForeigner": false,
+				"ci": "test_ci",
+				"di": "test_di"
+			},
+			"verifiedAt": "2024-01-01T00:01:00Z",
+			"pgTxId": "test_pg_tx_id"
+		}`))
+	})
+
+	iv, err := client.GetIdentityVerification(context.Background(), v2.GetIdentityVerificationRequest{
+		IdentityVerificationID: "test_identity_verification_id",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verified, ok := iv.(*v2.VerifiedIdentityVerification)
+	if !ok {
+		t.Fatalf("unexpected identity verification type: %T", iv)
+	}
+
+	want := v2.VerifiedCustomer{
+		Name:        "홍길동",
+		Operator:    v2.CarrierSKT,
+		PhoneNumber: "01012345678",
+		BirthDate:   time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		Gender:      v2.GenderMale,
+		CI:          "test_ci",
+		DI:          "test_di",
+	}
+	if diff := cmp.Diff(want, verified.VerifiedCustomer); diff != "" {
+		t.Errorf("unexpected verified customer (-want +got):\n%s", diff)
+	}
+	if verified.Common().Status != v2.IdentityVerificationStatusVerified || verified.PgTxID != "test_pg_tx_id" {
+		t.Errorf("unexpected identity verification: %+v", verified)
+	}
+}
+
+func TestUnmarshalIdentityVerification(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want v2.IdentityVerification
+	}{
+		{
+			name: "ready",
+			data: `{"status": "READY", "id": "iv", "requestedCustomer": {"name": "홍길동"}}`,
+			want: func() v2.IdentityVerification {
+				iv := &v2.ReadyIdentityVerification{
+					IdentityVerificationBase: v2.IdentityVerificationBase{Status: v2.IdentityVerificationStatusReady, ID: "iv"},
+				}
+				iv.RequestedCustomer.Name = "홍길동"
+				return iv
+			}(),
+		},
+		{
+			name: "failed",
+			data: `{"status": "FAILED", "id": "iv", "failure": {"reason": "timeout", "pgCode": "E01"}}`,
+			want: &v2.FailedIdentityVerification{
+				IdentityVerificationBase: v2.IdentityVerificationBase{Status: v2.IdentityVerificationStatusFailed, ID: "iv"},
+				Failure:                  v2.IdentityVerificationFailure{Reason: "timeout", PgCode: "E01"},
+			},
+		},
+		{
+			name: "unrecognized",
+			data: `{"status": "EXPIRED", "id": "iv"}`,
+			want: &v2.UnrecognizedIdentityVerification{
+				IdentityVerificationBase: v2.IdentityVerificationBase{Status: "EXPIRED", ID: "iv"},
+				Raw:                      json.RawMessage(`{"status": "EXPIRED", "id": "iv"}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v2.UnmarshalIdentityVerification([]byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected identity verification (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfirmIdentityVerification(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/identity-verifications/test_identity_verification_id/confirm", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{"storeId": testStoreID, "otp": "123456"}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"identityVerification": {
+			"status": "VERIFIED",
+			"id": "test_identity_verification_id",
+			"verifiedCustomer": {"name": "홍길동", "birthDate": "1990-05-17"}
+		}}`))
+	})
+
+	resp, err := client.ConfirmIdentityVerification(context.Background(), v2.ConfirmIdentityVerificationRequest{
+		IdentityVerificationID: "test_identity_verification_id",
+		OTP:                    "123456",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := resp.IdentityVerification.VerifiedCustomer.BirthDate; !got.Equal(time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected birth date: %v", got)
+	}
+}
+
+func TestSendIdentityVerification(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/identity-verifications/test_identity_verification_id/send", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.SendIdentityVerificationRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := v2.SendIdentityVerificationRequest{
+			StoreID:    testStoreID,
+			ChannelKey: "test_channel_key",
+			Customer: v2.IdentityVerificationCustomer{
+				Name:        "홍길동",
+				PhoneNumber: "01012345678",
+				IPAddress:   "127.0.0.1",
+			},
+			Operator: v2.CarrierKT,
+			Method:   v2.IdentityVerificationMethodSMS,
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	err := client.SendIdentityVerification(context.Background(), v2.SendIdentityVerificationRequest{
+		IdentityVerificationID: "test_identity_verification_id",
+		ChannelKey:             "test_channel_key",
+		Customer: v2.IdentityVerificationCustomer{
+			Name:        "홍길동",
+			PhoneNumber: "01012345678",
+			IPAddress:   "127.0.0.1",
+		},
+		Operator: v2.CarrierKT,
+		Method:   v2.IdentityVerificationMethodSMS,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
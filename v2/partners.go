@@ -0,0 +1,296 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// PlatformPartnerStatus is the review status of a platform partner.
+type PlatformPartnerStatus string
+
+const (
+	PlatformPartnerStatusPending  PlatformPartnerStatus = "PENDING"
+	PlatformPartnerStatusApproved PlatformPartnerStatus = "APPROVED"
+	PlatformPartnerStatusRejected PlatformPartnerStatus = "REJECTED"
+)
+
+// PlatformPartnerTypeKind is the legal kind of a platform partner, which decides how it is taxed.
+type PlatformPartnerTypeKind string
+
+const (
+	// PlatformPartnerTypeBusiness is a partner registered as a business (사업자).
+	PlatformPartnerTypeBusiness PlatformPartnerTypeKind = "BUSINESS"
+	// PlatformPartnerTypeWhtPayer is an individual whose income is withheld at source (원천징수 대상 개인).
+	PlatformPartnerTypeWhtPayer PlatformPartnerTypeKind = "WHT_PAYER"
+	// PlatformPartnerTypeNonWhtPayer is an individual whose income is not withheld at source (원천징수 비대상 개인).
+	PlatformPartnerTypeNonWhtPayer PlatformPartnerTypeKind = "NON_WHT_PAYER"
+)
+
+// PlatformTaxationType is the taxation type of a business partner.
+type PlatformTaxationType string
+
+const (
+	PlatformTaxationTypeNormal                 PlatformTaxationType = "NORMAL"
+	PlatformTaxationTypeSimpleTaxInvoiceIssuer PlatformTaxationType = "SIMPLE_TAX_INVOICE_ISSUER"
+	PlatformTaxationTypeSimple                 PlatformTaxationType = "SIMPLE"
+	PlatformTaxationTypeTaxFree                PlatformTaxationType = "TAX_FREE"
+	PlatformTaxationTypeAssignedID             PlatformTaxationType = "ASSIGNED_ID_NUMBER"
+)
+
+// PlatformBusinessStatus is the status of a business partner at the National Tax Service.
+type PlatformBusinessStatus string
+
+const (
+	PlatformBusinessStatusInBusiness PlatformBusinessStatus = "IN_BUSINESS"
+	PlatformBusinessStatusClosed     PlatformBusinessStatus = "CLOSED"
+	PlatformBusinessStatusSuspended  PlatformBusinessStatus = "SUSPENDED"
+)
+
+// PlatformPartnerType holds the legal information of a platform partner.
+// The business fields are only set when Type is PlatformPartnerTypeBusiness,
+// and Birthdate is only set for individuals.
+type PlatformPartnerType struct {
+	Type                       PlatformPartnerTypeKind `json:"type"`
+	CompanyName                string                  `json:"companyName,omitempty"`
+	TaxationType               PlatformTaxationType    `json:"taxationType,omitempty"`
+	BusinessStatus             PlatformBusinessStatus  `json:"businessStatus,omitempty"`
+	BusinessRegistrationNumber string                  `json:"businessRegistrationNumber,omitempty"`
+	RepresentativeName         string                  `json:"representativeName,omitempty"`
+	CompanyAddress             string                  `json:"companyAddress,omitempty"`
+	BusinessType               string                  `json:"businessType,omitempty"`
+	BusinessClass              string                  `json:"businessClass,omitempty"`
+	// Birthdate is formatted as YYYY-MM-DD.
+	Birthdate string `json:"birthdate,omitempty"`
+}
+
+// PlatformPartnerBusinessInput is the legal information of a business partner.
+type PlatformPartnerBusinessInput struct {
+	CompanyName                string `json:"companyName"`
+	BusinessRegistrationNumber string `json:"businessRegistrationNumber"`
+	RepresentativeName         string `json:"representativeName"`
+	CompanyAddress             string `json:"companyAddress,omitempty"`
+	BusinessType               string `json:"businessType,omitempty"`
+	BusinessClass              string `json:"businessClass,omitempty"`
+}
+
+// PlatformPartnerIndividualInput is the legal information of an individual partner.
+type PlatformPartnerIndividualInput struct {
+	// Birthdate is formatted as YYYY-MM-DD.
+	Birthdate string `json:"birthdate,omitempty"`
+}
+
+// PlatformPartnerTypeInput selects the legal kind of a partner. Exactly one field must be set.
+type PlatformPartnerTypeInput struct {
+	Business    *PlatformPartnerBusinessInput   `json:"business,omitempty"`
+	WhtPayer    *PlatformPartnerIndividualInput `json:"whtPayer,omitempty"`
+	NonWhtPayer *PlatformPartnerIndividualInput `json:"nonWhtPayer,omitempty"`
+}
+
+// PlatformContact is the person in charge of a platform partner.
+type PlatformContact struct {
+	Name        string `json:"name"`
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+	Email       string `json:"email"`
+}
+
+// PlatformAccountStatus is the verification status of the bank account of a partner.
+type PlatformAccountStatus string
+
+const (
+	PlatformAccountStatusVerifying   PlatformAccountStatus = "VERIFYING"
+	PlatformAccountStatusVerified    PlatformAccountStatus = "VERIFIED"
+	PlatformAccountStatusFailed      PlatformAccountStatus = "VERIFY_FAILED"
+	PlatformAccountStatusNotVerified PlatformAccountStatus = "NOT_VERIFIED"
+	PlatformAccountStatusUnknown     PlatformAccountStatus = "UNKNOWN"
+)
+
+// PlatformAccount is the bank account the settlements of a partner are transferred to.
+type PlatformAccount struct {
+	Bank       Bank                  `json:"bank"`
+	Currency   Currency              `json:"currency"`
+	Number     string                `json:"number"`
+	HolderName string                `json:"holderName"`
+	Status     PlatformAccountStatus `json:"status,omitempty"`
+}
+
+// PlatformAccountInput is the bank account of a partner to register.
+type PlatformAccountInput struct {
+	Bank       Bank     `json:"bank"`
+	Currency   Currency `json:"currency"`
+	Number     string   `json:"number"`
+	HolderName string   `json:"holderName"`
+	// AccountVerificationID is the ID of a prior account holder verification, if any.
+	AccountVerificationID string `json:"accountVerificationId,omitempty"`
+}
+
+// PlatformPartner represents a partner the revenue of the platform is settled to.
+type PlatformPartner struct {
+	ID                string                `json:"id"`
+	Name              string                `json:"name"`
+	Contact           PlatformContact       `json:"contact"`
+	Account           PlatformAccount       `json:"account"`
+	Status            PlatformPartnerStatus `json:"status"`
+	DefaultContractID string                `json:"defaultContractId"`
+	Memo              string                `json:"memo,omitempty"`
+	Tags              []string              `json:"tags"`
+	Type              PlatformPartnerType   `json:"type"`
+	IsArchived        bool                  `json:"isArchived"`
+	AppliedAt         *time.Time            `json:"appliedAt,omitempty"`
+}
+
+// CreatePlatformPartnerRequest represents a request for 'POST /platform/partners'.
+type CreatePlatformPartnerRequest struct {
+	// ID is generated by PortOne when empty.
+	ID                string                   `json:"id,omitempty"`
+	Name              string                   `json:"name"`
+	Contact           PlatformContact          `json:"contact"`
+	Account           PlatformAccountInput     `json:"account"`
+	DefaultContractID string                   `json:"defaultContractId"`
+	Memo              string                   `json:"memo,omitempty"`
+	Tags              []string                 `json:"tags,omitempty"`
+	Type              PlatformPartnerTypeInput `json:"type"`
+}
+
+// PlatformPartnerResponse represents a response of the endpoints returning a single partner.
+type PlatformPartnerResponse struct {
+	Partner PlatformPartner `json:"partner"`
+}
+
+// CreatePlatformPartner registers a partner.
+func (ps *platformService) CreatePlatformPartner(ctx context.Context, req CreatePlatformPartnerRequest) (PlatformPartnerResponse, error) {
+	var resp PlatformPartnerResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath("/partners"), req, &resp)
+	if err != nil {
+		return PlatformPartnerResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// CreatePlatformPartnersRequest represents a request for 'POST /platform/partners/batch'.
+type CreatePlatformPartnersRequest struct {
+	Partners []CreatePlatformPartnerRequest `json:"partners"`
+}
+
+// CreatePlatformPartnersResponse represents a response of 'POST /platform/partners/batch'.
+type CreatePlatformPartnersResponse struct {
+	Partners []PlatformPartner `json:"partners"`
+}
+
+// CreatePlatformPartners registers several partners at once. No partner is registered if one is invalid.
+func (ps *platformService) CreatePlatformPartners(ctx context.Context, req CreatePlatformPartnersRequest) (CreatePlatformPartnersResponse, error) {
+	var resp CreatePlatformPartnersResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath("/partners/batch"), req, &resp)
+	if err != nil {
+		return CreatePlatformPartnersResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetPlatformPartner returns a partner.
+func (ps *platformService) GetPlatformPartner(ctx context.Context, partnerID string) (PlatformPartner, error) {
+	var resp PlatformPartner
+	err := ps.send(ctx, http.MethodGet, ps.baseURL.JoinPath("/partners", partnerID), nil, &resp)
+	if err != nil {
+		return PlatformPartner{}, err
+	}
+
+	return resp, nil
+}
+
+// PlatformPartnerKeyword searches partners by text. Zero fields are ignored.
+type PlatformPartnerKeyword struct {
+	All                        string `json:"all,omitempty"`
+	ID                         string `json:"id,omitempty"`
+	Name                       string `json:"name,omitempty"`
+	Email                      string `json:"email,omitempty"`
+	BusinessRegistrationNumber string `json:"businessRegistrationNumber,omitempty"`
+	DefaultContractID          string `json:"defaultContractId,omitempty"`
+	Memo                       string `json:"memo,omitempty"`
+	AccountNumber              string `json:"accountNumber,omitempty"`
+	AccountHolder              string `json:"accountHolder,omitempty"`
+}
+
+// PlatformPartnerFilter restricts a partner search. Zero fields are ignored.
+type PlatformPartnerFilter struct {
+	// IsArchived selects archived or active partners. Both are returned when nil.
+	IsArchived    *bool                     `json:"isArchived,omitempty"`
+	Tags          []string                  `json:"tags,omitempty"`
+	Banks         []Bank                    `json:"banks,omitempty"`
+	TaxationTypes []PlatformTaxationType    `json:"taxationTypes,omitempty"`
+	Statuses      []PlatformPartnerStatus   `json:"statuses,omitempty"`
+	PartnerTypes  []PlatformPartnerTypeKind `json:"partnerTypes,omitempty"`
+	ContractIDs   []string                  `json:"contractIds,omitempty"`
+	Keyword       *PlatformPartnerKeyword   `json:"keyword,omitempty"`
+}
+
+// ListPlatformPartnersRequest represents a request for 'GET /platform/partners'.
+type ListPlatformPartnersRequest struct {
+	Page   *PageInput             `json:"page,omitempty"`
+	Filter *PlatformPartnerFilter `json:"filter,omitempty"`
+}
+
+// ListPlatformPartnersResponse represents a response of 'GET /platform/partners'.
+type ListPlatformPartnersResponse struct {
+	Items []PlatformPartner `json:"items"`
+	Page  PageInfo          `json:"page"`
+}
+
+// ListPlatformPartners returns the partners matching the filter, one page at a time.
+func (ps *platformService) ListPlatformPartners(ctx context.Context, req ListPlatformPartnersRequest) (ListPlatformPartnersResponse, error) {
+	var resp ListPlatformPartnersResponse
+	err := ps.search(ctx, ps.baseURL.JoinPath("/partners"), req, &resp)
+	if err != nil {
+		return ListPlatformPartnersResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// UpdatePlatformPartnerRequest represents a request for 'PATCH /platform/partners/{id}'.
+// Nil fields are left unchanged.
+type UpdatePlatformPartnerRequest struct {
+	ID                string                    `json:"-"`
+	Name              *string                   `json:"name,omitempty"`
+	Contact           *PlatformContact          `json:"contact,omitempty"`
+	Account           *PlatformAccountInput     `json:"account,omitempty"`
+	DefaultContractID *string                   `json:"defaultContractId,omitempty"`
+	Memo              *string                   `json:"memo,omitempty"`
+	Tags              *[]string                 `json:"tags,omitempty"`
+	Type              *PlatformPartnerTypeInput `json:"type,omitempty"`
+}
+
+// UpdatePlatformPartner updates a partner.
+func (ps *platformService) UpdatePlatformPartner(ctx context.Context, req UpdatePlatformPartnerRequest) (PlatformPartnerResponse, error) {
+	var resp PlatformPartnerResponse
+	err := ps.send(ctx, http.MethodPatch, ps.baseURL.JoinPath("/partners", req.ID), req, &resp)
+	if err != nil {
+		return PlatformPartnerResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// ArchivePlatformPartner archives a partner. Archived partners cannot be the target of new transfers.
+func (ps *platformService) ArchivePlatformPartner(ctx context.Context, partnerID string) (PlatformPartnerResponse, error) {
+	var resp PlatformPartnerResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath("/partners", partnerID, "/archive"), nil, &resp)
+	if err != nil {
+		return PlatformPartnerResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// RecoverPlatformPartner restores an archived partner.
+func (ps *platformService) RecoverPlatformPartner(ctx context.Context, partnerID string) (PlatformPartnerResponse, error) {
+	var resp PlatformPartnerResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath("/partners", partnerID, "/recover"), nil, &resp)
+	if err != nil {
+		return PlatformPartnerResponse{}, err
+	}
+
+	return resp, nil
+}
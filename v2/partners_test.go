@@ -0,0 +1,239 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreatePlatformPartner(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/partners", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"name":    "test_partner",
+			"contact": map[string]any{"name": "홍길동", "email": "partner@example.com"},
+			"account": map[string]any{
+				"bank":       "KOOKMIN",
+				"currency":   "KRW",
+				"number":     "1234567890",
+				"holderName": "홍길동",
+			},
+			"defaultContractId": "test_contract_id",
+			"tags":              []any{"seller"},
+			"type":              map[string]any{"whtPayer": map[string]any{"birthdate": "1990-05-17"}},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"partner": {
+			"id": "test_partner_id",
+			"name": "test_partner",
+			"contact": {"name": "홍길동", "email": "partner@example.com"},
+			"account": {"bank": "KOOKMIN", "currency": "KRW", "number": "1234567890", "holderName": "홍길동", "status": "VERIFIED"},
+			"status": "APPROVED",
+			"defaultContractId": "test_contract_id",
+			"tags": ["seller"],
+			"type": {"type": "WHT_PAYER", "birthdate": "1990-05-17"},
+			"isArchived": false
+		}}`))
+	})
+
+	resp, err := client.CreatePlatformPartner(context.Background(), v2.CreatePlatformPartnerRequest{
+		Name:    "test_partner",
+		Contact: v2.PlatformContact{Name: "홍길동", Email: "partner@example.com"},
+		Account: v2.PlatformAccountInput{
+			Bank:       "KOOKMIN",
+			Currency:   v2.CurrencyKRW,
+			Number:     "1234567890",
+			HolderName: "홍길동",
+		},
+		DefaultContractID: "test_contract_id",
+		Tags:              []string{"seller"},
+		Type: v2.PlatformPartnerTypeInput{
+			WhtPayer: &v2.PlatformPartnerIndividualInput{Birthdate: "1990-05-17"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.PlatformPartner{
+		ID:      "test_partner_id",
+		Name:    "test_partner",
+		Contact: v2.PlatformContact{Name: "홍길동", Email: "partner@example.com"},
+		Account: v2.PlatformAccount{
+			Bank:       "KOOKMIN",
+			Currency:   v2.CurrencyKRW,
+			Number:     "1234567890",
+			HolderName: "홍길동",
+			Status:     v2.PlatformAccountStatusVerified,
+		},
+		Status:            v2.PlatformPartnerStatusApproved,
+		DefaultContractID: "test_contract_id",
+		Tags:              []string{"seller"},
+		Type:              v2.PlatformPartnerType{Type: v2.PlatformPartnerTypeWhtPayer, Birthdate: "1990-05-17"},
+	}
+	if diff := cmp.Diff(want, resp.Partner); diff != "" {
+		t.Errorf("unexpected partner (-want +got):\n%s", diff)
+	}
+}
+
+func TestCreatePlatformPartnerWithoutTags(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/partners", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+		if tags, ok := body["tags"]; ok {
+			t.Errorf("unexpected tags: %v", tags)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"partner": {"id": "test_partner_id", "tags": []}}`))
+	})
+
+	_, err := client.CreatePlatformPartner(context.Background(), v2.CreatePlatformPartnerRequest{
+		Name:              "test_partner",
+		DefaultContractID: "test_contract_id",
+		Type: v2.PlatformPartnerTypeInput{
+			WhtPayer: &v2.PlatformPartnerIndividualInput{Birthdate: "1990-05-17"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListPlatformPartners(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	archived := false
+
+	mux.HandleFunc("/platform/partners", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.ListPlatformPartnersRequest
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("requestBody")), &body); err != nil {
+			t.Errorf("unexpected request body: %v", err)
+		}
+
+		want := v2.ListPlatformPartnersRequest{
+			Page: &v2.PageInput{Size: 10},
+			Filter: &v2.PlatformPartnerFilter{
+				IsArchived:   &archived,
+				PartnerTypes: []v2.PlatformPartnerTypeKind{v2.PlatformPartnerTypeBusiness},
+				Keyword:      &v2.PlatformPartnerKeyword{Name: "test"},
+			},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected request body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"items": [{"id": "test_partner_id", "type": {"type": "BUSINESS", "companyName": "test_company", "taxationType": "NORMAL"}}],
+			"page": {"number": 0, "size": 10, "totalCount": 1}
+		}`))
+	})
+
+	resp, err := client.ListPlatformPartners(context.Background(), v2.ListPlatformPartnersRequest{
+		Page: &v2.PageInput{Size: 10},
+		Filter: &v2.PlatformPartnerFilter{
+			IsArchived:   &archived,
+			PartnerTypes: []v2.PlatformPartnerTypeKind{v2.PlatformPartnerTypeBusiness},
+			Keyword:      &v2.PlatformPartnerKeyword{Name: "test"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Items) != 1 || resp.Items[0].Type.CompanyName != "test_company" || resp.Page.HasNext() {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestUpdatePlatformPartner(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/partners/test_partner_id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{"memo": "", "tags": []any{}}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"partner": {"id": "test_partner_id", "tags": []}}`))
+	})
+
+	memo := ""
+	tags := []string{}
+	resp, err := client.UpdatePlatformPartner(context.Background(), v2.UpdatePlatformPartnerRequest{
+		ID:   "test_partner_id",
+		Memo: &memo,
+		Tags: &tags,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Partner.ID != "test_partner_id" {
+		t.Errorf("unexpected partner id: %s", resp.Partner.ID)
+	}
+}
+
+func TestArchivePlatformPartner(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/partners/test_partner_id/archive", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"partner": {"id": "test_partner_id", "isArchived": true}}`))
+	})
+
+	resp, err := client.ArchivePlatformPartner(context.Background(), "test_partner_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !resp.Partner.IsArchived {
+		t.Errorf("expected the partner to be archived")
+	}
+}
@@ -0,0 +1,250 @@
+package v2
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// PaymentStatus is the status of a payment.
+type PaymentStatus string
+
+const (
+	PaymentStatusReady                PaymentStatus = "READY"
+	PaymentStatusPaid                 PaymentStatus = "PAID"
+	PaymentStatusFailed               PaymentStatus = "FAILED"
+	PaymentStatusCancelled            PaymentStatus = "CANCELLED"
+	PaymentStatusPartialCancelled     PaymentStatus = "PARTIAL_CANCELLED"
+	PaymentStatusVirtualAccountIssued PaymentStatus = "VIRTUAL_ACCOUNT_ISSUED"
+)
+
+// Payment is a payment in one of its states. Use a type switch to access the fields specific to a state:
+//
+//	switch p := payment.(type) {
+//	case *v2.PaidPayment:
+//		fmt.Println(p.PaidAt)
+//	case *v2.FailedPayment:
+//		fmt.Println(p.Failure.Reason)
+//	}
+type Payment interface {
+	// Common returns the fields shared by every state.
+	Common() *PaymentBase
+}
+
+// PaymentAmount represents the amount breakdown of a payment.
+type PaymentAmount struct {
+	Total            int64 `json:"total"`
+	TaxFree          int64 `json:"taxFree"`
+	Vat              int64 `json:"vat"`
+	Supply           int64 `json:"supply"`
+	Discount         int64 `json:"discount"`
+	Paid             int64 `json:"paid"`
+	Cancelled        int64 `json:"cancelled"`
+	CancelledTaxFree int64 `json:"cancelledTaxFree"`
+}
+
+// PaymentMethodType is the type of the method a payment was made with.
+type PaymentMethodType string
+
+const (
+	PaymentMethodTypeCard            PaymentMethodType = "PaymentMethodCard"
+	PaymentMethodTypeVirtualAccount  PaymentMethodType = "PaymentMethodVirtualAccount"
+	PaymentMethodTypeEasyPay         PaymentMethodType = "PaymentMethodEasyPay"
+	PaymentMethodTypeTransfer        PaymentMethodType = "PaymentMethodTransfer"
+	PaymentMethodTypeMobile          PaymentMethodType = "PaymentMethodMobile"
+	PaymentMethodTypeGiftCertificate PaymentMethodType = "PaymentMethodGiftCertificate"
+	PaymentMethodTypePaypal          PaymentMethodType = "PaymentMethodPaypal"
+)
+
+// Card represents the card a payment or a billing key was made with.
+type Card struct {
+	Publisher string `json:"publisher"`
+	Issuer    string `json:"issuer"`
+	Brand     string `json:"brand"`
+	Type      string `json:"type"`
+	OwnerType string `json:"ownerType"`
+	Bin       string `json:"bin"`
+	Name      string `json:"name"`
+	Number    string `json:"number"`
+}
+
+// PaymentInstallment represents the installment plan of a card payment.
+type PaymentInstallment struct {
+	Month          int  `json:"month"`
+	IsInterestFree bool `json:"isInterestFree"`
+}
+
+// PaymentMethod represents the method a payment was made with. Only the fields matching Type are set.
+type PaymentMethod struct {
+	Type PaymentMethodType `json:"type"`
+
+	// Card payments.
+	Card           *Card               `json:"card,omitempty"`
+	ApprovalNumber string              `json:"approvalNumber,omitempty"`
+	Installment    *PaymentInstallment `json:"installment,omitempty"`
+	PointUsed      bool                `json:"pointUsed,omitempty"`
+
+	// Virtual account payments.
+	Bank          Bank       `json:"bank,omitempty"`
+	AccountNumber string     `json:"accountNumber,omitempty"`
+	RemitteeName  string     `json:"remitteeName,omitempty"`
+	RemitterName  string     `json:"remitterName,omitempty"`
+	DepositorName string     `json:"depositorName,omitempty"`
+	ExpiredAt     *time.Time `json:"expiredAt,omitempty"`
+	IssuedAt      *time.Time `json:"issuedAt,omitempty"`
+
+	// Easy pay payments.
+	Provider      string         `json:"provider,omitempty"`
+	EasyPayMethod *PaymentMethod `json:"easyPayMethod,omitempty"`
+
+	// Mobile payments.
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+}
+
+// PaymentBase holds the fields shared by every payment state.
+type PaymentBase struct {
+	Status            PaymentStatus    `json:"status"`
+	ID                string           `json:"id"`
+	TransactionID     string           `json:"transactionId"`
+	MerchantID        string           `json:"merchantId"`
+	StoreID           string           `json:"storeId"`
+	Method            *PaymentMethod   `json:"method,omitempty"`
+	Channel           *SelectedChannel `json:"channel,omitempty"`
+	Version           string           `json:"version"`
+	RequestedAt       time.Time        `json:"requestedAt"`
+	UpdatedAt         time.Time        `json:"updatedAt"`
+	StatusChangedAt   time.Time        `json:"statusChangedAt"`
+	OrderName         string           `json:"orderName"`
+	Amount            PaymentAmount    `json:"amount"`
+	Currency          Currency         `json:"currency"`
+	Customer          Customer         `json:"customer"`
+	CustomData        string           `json:"customData,omitempty"`
+	IsCulturalExpense bool             `json:"isCulturalExpense,omitempty"`
+}
+
+// Common implements Payment.
+func (b *PaymentBase) Common() *PaymentBase {
+	return b
+}
+
+// CancellableAmount returns the amount which can still be cancelled. Pass it as the
+// CurrentCancellableAmount of a cancellation to make sure it is not based on stale data.
+func (b *PaymentBase) CancellableAmount() int64 {
+	return b.Amount.Total - b.Amount.Cancelled
+}
+
+// ReadyPayment is a payment which has been requested but not paid yet.
+type ReadyPayment struct {
+	PaymentBase
+}
+
+// PaidPayment is a payment which has been paid.
+type PaidPayment struct {
+	PaymentBase
+	PaidAt     time.Time `json:"paidAt"`
+	PgTxID     string    `json:"pgTxId"`
+	ReceiptURL string    `json:"receiptUrl"`
+}
+
+// PaymentFailure describes why a payment failed.
+type PaymentFailure struct {
+	Reason    string `json:"reason"`
+	PgCode    string `json:"pgCode"`
+	PgMessage string `json:"pgMessage"`
+}
+
+// FailedPayment is a payment which failed.
+type FailedPayment struct {
+	PaymentBase
+	FailedAt time.Time      `json:"failedAt"`
+	Failure  PaymentFailure `json:"failure"`
+}
+
+// PaymentCancellationStatus is the status of a payment cancellation.
+type PaymentCancellationStatus string
+
+const (
+	PaymentCancellationStatusRequested PaymentCancellationStatus = "REQUESTED"
+	PaymentCancellationStatusFailed    PaymentCancellationStatus = "FAILED"
+	PaymentCancellationStatusSucceeded PaymentCancellationStatus = "SUCCEEDED"
+)
+
+// PaymentCancellation represents a full or partial cancellation of a payment.
+type PaymentCancellation struct {
+	Status                PaymentCancellationStatus `json:"status"`
+	ID                    string                    `json:"id"`
+	PgCancellationID      string                    `json:"pgCancellationId"`
+	TotalAmount           int64                     `json:"totalAmount"`
+	TaxFreeAmount         int64                     `json:"taxFreeAmount"`
+	VatAmount             int64                     `json:"vatAmount"`
+	EasyPayDiscountAmount int64                     `json:"easyPayDiscountAmount"`
+	Reason                string                    `json:"reason"`
+	CancelledAt           *time.Time                `json:"cancelledAt,omitempty"`
+	RequestedAt           time.Time                 `json:"requestedAt"`
+}
+
+// CancelledPayment is a payment which has been fully cancelled.
+type CancelledPayment struct {
+	PaymentBase
+	PaidAt        *time.Time            `json:"paidAt,omitempty"`
+	PgTxID        string                `json:"pgTxId"`
+	ReceiptURL    string                `json:"receiptUrl"`
+	Cancellations []PaymentCancellation `json:"cancellations"`
+	CancelledAt   time.Time             `json:"cancelledAt"`
+}
+
+// PartialCancelledPayment is a payment which has been partially cancelled.
+type PartialCancelledPayment struct {
+	PaymentBase
+	PaidAt        *time.Time            `json:"paidAt,omitempty"`
+	PgTxID        string                `json:"pgTxId"`
+	ReceiptURL    string                `json:"receiptUrl"`
+	Cancellations []PaymentCancellation `json:"cancellations"`
+	CancelledAt   time.Time             `json:"cancelledAt"`
+}
+
+// VirtualAccountIssuedPayment is a payment waiting for the deposit on its virtual account.
+// The account is described by the Method.
+type VirtualAccountIssuedPayment struct {
+	PaymentBase
+	PgTxID string `json:"pgTxId"`
+}
+
+// UnrecognizedPayment is a payment in a state unknown to this package. Raw holds its JSON representation.
+type UnrecognizedPayment struct {
+	PaymentBase
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalPayment decodes a payment into the type matching its status.
+func UnmarshalPayment(data []byte) (Payment, error) {
+	var probe struct {
+		Status PaymentStatus `json:"status"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, err
+	}
+
+	var p Payment
+	switch probe.Status {
+	case PaymentStatusReady:
+		p = &ReadyPayment{}
+	case PaymentStatusPaid:
+		p = &PaidPayment{}
+	case PaymentStatusFailed:
+		p = &FailedPayment{}
+	case PaymentStatusCancelled:
+		p = &CancelledPayment{}
+	case PaymentStatusPartialCancelled:
+		p = &PartialCancelledPayment{}
+	case PaymentStatusVirtualAccountIssued:
+		p = &VirtualAccountIssuedPayment{}
+	default:
+		p = &UnrecognizedPayment{Raw: append(json.RawMessage(nil), data...)}
+	}
+
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,315 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type paymentsService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	storeID    string
+}
+
+func newPaymentsService(baseURL *url.URL, httpClient *http.Client, storeID string) *paymentsService {
+	return &paymentsService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		storeID:    storeID,
+	}
+}
+
+// GetPaymentRequest represents a request for 'GET /payments/{paymentId}'.
+type GetPaymentRequest struct {
+	PaymentID string
+	// StoreID defaults to the store of the client.
+	StoreID string
+}
+
+// GetPayment returns a payment.
+func (ps *paymentsService) GetPayment(ctx context.Context, req GetPaymentRequest) (Payment, error) {
+	u := ps.baseURL.JoinPath(req.PaymentID)
+	setStoreIDQuery(u, orDefault(req.StoreID, ps.storeID))
+
+	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw json.RawMessage
+	err = do(ps.httpClient, httpReq, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return UnmarshalPayment(raw)
+}
+
+// PaymentTimestampType is the timestamp a payment search filters on.
+type PaymentTimestampType string
+
+const (
+	PaymentTimestampTypeCreatedAt       PaymentTimestampType = "CREATED_AT"
+	PaymentTimestampTypeStatusChangedAt PaymentTimestampType = "STATUS_CHANGED_AT"
+)
+
+// PaymentFilter restricts a payment search. Zero fields are ignored.
+type PaymentFilter struct {
+	StoreID       string               `json:"storeId,omitempty"`
+	TimestampType PaymentTimestampType `json:"timestampType,omitempty"`
+	From          *time.Time           `json:"from,omitempty"`
+	Until         *time.Time           `json:"until,omitempty"`
+	Status        []PaymentStatus      `json:"status,omitempty"`
+	Methods       []PaymentMethodType  `json:"methods,omitempty"`
+	PgProvider    []string             `json:"pgProvider,omitempty"`
+	IsTest        *bool                `json:"isTest,omitempty"`
+	Currency      Currency             `json:"currency,omitempty"`
+	OrderName     string               `json:"orderName,omitempty"`
+	CustomerName  string               `json:"customerName,omitempty"`
+}
+
+// SearchPaymentsRequest represents a request for 'GET /payments'.
+type SearchPaymentsRequest struct {
+	Page   *PageInput     `json:"page,omitempty"`
+	Filter *PaymentFilter `json:"filter,omitempty"`
+}
+
+// SearchPaymentsResponse represents a response of 'GET /payments'.
+type SearchPaymentsResponse struct {
+	Items []Payment
+	Page  PageInfo
+}
+
+// UnmarshalJSON decodes every item into the type matching its status.
+func (r *SearchPaymentsResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Items []json.RawMessage `json:"items"`
+		Page  PageInfo          `json:"page"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	items := make([]Payment, 0, len(raw.Items))
+	for _, item := range raw.Items {
+		p, err := UnmarshalPayment(item)
+		if err != nil {
+			return err
+		}
+		items = append(items, p)
+	}
+
+	r.Items = items
+	r.Page = raw.Page
+	return nil
+}
+
+// SearchPayments returns the payments matching the filter, one page at a time.
+func (ps *paymentsService) SearchPayments(ctx context.Context, req SearchPaymentsRequest) (SearchPaymentsResponse, error) {
+	if req.Filter == nil {
+		req.Filter = &PaymentFilter{}
+	}
+	if req.Filter.StoreID == "" {
+		filter := *req.Filter
+		filter.StoreID = ps.storeID
+		req.Filter = &filter
+	}
+
+	u := ps.baseURL.JoinPath()
+	if err := setRequestBodyQuery(u, req); err != nil {
+		return SearchPaymentsResponse{}, err
+	}
+
+	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return SearchPaymentsResponse{}, err
+	}
+
+	var resp SearchPaymentsResponse
+	err = do(ps.httpClient, httpReq, &resp)
+	if err != nil {
+		return SearchPaymentsResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// CancelRequester is the party requesting a cancellation.
+type CancelRequester string
+
+const (
+	CancelRequesterCustomer CancelRequester = "CUSTOMER"
+	CancelRequesterAdmin    CancelRequester = "ADMIN"
+)
+
+// RefundAccount represents the bank account a virtual account payment is refunded to.
+type RefundAccount struct {
+	Bank              Bank   `json:"bank"`
+	Number            string `json:"number"`
+	HolderName        string `json:"holderName"`
+	HolderPhoneNumber string `json:"holderPhoneNumber,omitempty"`
+}
+
+// CancelPaymentRequest represents a request for 'POST /payments/{paymentId}/cancel'.
+type CancelPaymentRequest struct {
+	PaymentID string `json:"-"`
+	StoreID   string `json:"storeId,omitempty"`
+	// Amount is the amount to cancel. The whole payment is cancelled when nil.
+	Amount        *int64          `json:"amount,omitempty"`
+	TaxFreeAmount *int64          `json:"taxFreeAmount,omitempty"`
+	VatAmount     *int64          `json:"vatAmount,omitempty"`
+	Reason        string          `json:"reason"`
+	Requester     CancelRequester `json:"requester,omitempty"`
+	// CurrentCancellableAmount makes the API reject the cancellation with a
+	// CANCELLABLE_AMOUNT_CONSISTENCY_BROKEN error if the cancellable amount of the payment differs,
+	// protecting against cancellations based on stale data. See PaymentBase.CancellableAmount.
+	CurrentCancellableAmount *int64         `json:"currentCancellableAmount,omitempty"`
+	RefundAccount            *RefundAccount `json:"refundAccount,omitempty"`
+}
+
+// CancelPaymentResponse represents a response of 'POST /payments/{paymentId}/cancel'.
+type CancelPaymentResponse struct {
+	Cancellation PaymentCancellation `json:"cancellation"`
+}
+
+// CancelPayment cancels a payment fully or partially.
+func (ps *paymentsService) CancelPayment(ctx context.Context, req CancelPaymentRequest) (CancelPaymentResponse, error) {
+	req.StoreID = orDefault(req.StoreID, ps.storeID)
+
+	u := ps.baseURL.JoinPath(req.PaymentID, "/cancel")
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	if err != nil {
+		return CancelPaymentResponse{}, err
+	}
+
+	var resp CancelPaymentResponse
+	err = do(ps.httpClient, httpReq, &resp)
+	if err != nil {
+		return CancelPaymentResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// PreRegisterPaymentRequest represents a request for 'POST /payments/{paymentId}/pre-register'.
+type PreRegisterPaymentRequest struct {
+	PaymentID     string   `json:"-"`
+	StoreID       string   `json:"storeId,omitempty"`
+	TotalAmount   int64    `json:"totalAmount"`
+	TaxFreeAmount *int64   `json:"taxFreeAmount,omitempty"`
+	Currency      Currency `json:"currency"`
+}
+
+// PreRegisterPayment registers the amount a payment is expected to be made with,
+// so PortOne rejects a payment whose amount was tampered with on the client side.
+func (ps *paymentsService) PreRegisterPayment(ctx context.Context, req PreRegisterPaymentRequest) error {
+	req.StoreID = orDefault(req.StoreID, ps.storeID)
+
+	u := ps.baseURL.JoinPath(req.PaymentID, "/pre-register")
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	if err != nil {
+		return err
+	}
+
+	return do(ps.httpClient, httpReq, nil)
+}
+
+// PaymentAmountInput represents the amount of a payment request.
+type PaymentAmountInput struct {
+	Total   int64  `json:"total"`
+	TaxFree *int64 `json:"taxFree,omitempty"`
+	Vat     *int64 `json:"vat,omitempty"`
+}
+
+// PayWithBillingKeyRequest represents a request for 'POST /payments/{paymentId}/billing-key'.
+type PayWithBillingKeyRequest struct {
+	PaymentID        string             `json:"-"`
+	StoreID          string             `json:"storeId,omitempty"`
+	BillingKey       string             `json:"billingKey"`
+	ChannelKey       string             `json:"channelKey,omitempty"`
+	OrderName        string             `json:"orderName"`
+	Customer         *Customer          `json:"customer,omitempty"`
+	CustomData       string             `json:"customData,omitempty"`
+	Amount           PaymentAmountInput `json:"amount"`
+	Currency         Currency           `json:"currency"`
+	InstallmentMonth int                `json:"installmentMonth,omitempty"`
+	NoticeURLs       []string           `json:"noticeUrls,omitempty"`
+	// Bypass holds PG specific parameters.
+	Bypass json.RawMessage `json:"bypass,omitempty"`
+}
+
+// BillingKeyPaymentSummary summarizes a payment made with a billing key or instantly.
+type BillingKeyPaymentSummary struct {
+	TransactionID string    `json:"transactionId,omitempty"`
+	PgTxID        string    `json:"pgTxId"`
+	PaidAt        time.Time `json:"paidAt"`
+}
+
+// PayWithBillingKeyResponse represents a response of 'POST /payments/{paymentId}/billing-key'.
+type PayWithBillingKeyResponse struct {
+	Payment BillingKeyPaymentSummary `json:"payment"`
+}
+
+// PayWithBillingKey charges a billing key.
+func (ps *paymentsService) PayWithBillingKey(ctx context.Context, req PayWithBillingKeyRequest) (PayWithBillingKeyResponse, error) {
+	req.StoreID = orDefault(req.StoreID, ps.storeID)
+
+	u := ps.baseURL.JoinPath(req.PaymentID, "/billing-key")
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	if err != nil {
+		return PayWithBillingKeyResponse{}, err
+	}
+
+	var resp PayWithBillingKeyResponse
+	err = do(ps.httpClient, httpReq, &resp)
+	if err != nil {
+		return PayWithBillingKeyResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// PayInstantlyRequest represents a request for 'POST /payments/{paymentId}/instant'.
+type PayInstantlyRequest struct {
+	PaymentID        string                    `json:"-"`
+	StoreID          string                    `json:"storeId,omitempty"`
+	ChannelKey       string                    `json:"channelKey,omitempty"`
+	ChannelGroupID   string                    `json:"channelGroupId,omitempty"`
+	Method           InstantPaymentMethodInput `json:"method"`
+	OrderName        string                    `json:"orderName"`
+	Customer         *Customer                 `json:"customer,omitempty"`
+	CustomData       string                    `json:"customData,omitempty"`
+	Amount           PaymentAmountInput        `json:"amount"`
+	Currency         Currency                  `json:"currency"`
+	InstallmentMonth int                       `json:"installmentMonth,omitempty"`
+	NoticeURLs       []string                  `json:"noticeUrls,omitempty"`
+	// Bypass holds PG specific parameters.
+	Bypass json.RawMessage `json:"bypass,omitempty"`
+}
+
+// PayInstantlyResponse represents a response of 'POST /payments/{paymentId}/instant'.
+type PayInstantlyResponse struct {
+	Payment BillingKeyPaymentSummary `json:"payment"`
+}
+
+// PayInstantly charges card credentials without keeping a billing key (수기 결제).
+func (ps *paymentsService) PayInstantly(ctx context.Context, req PayInstantlyRequest) (PayInstantlyResponse, error) {
+	req.StoreID = orDefault(req.StoreID, ps.storeID)
+
+	u := ps.baseURL.JoinPath(req.PaymentID, "/instant")
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	if err != nil {
+		return PayInstantlyResponse{}, err
+	}
+
+	var resp PayInstantlyResponse
+	err = do(ps.httpClient, httpReq, &resp)
+	if err != nil {
+		return PayInstantlyResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,352 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetPayment(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/test_payment_id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		checkAuthorization(t, r)
+
+		if got := r.URL.Query().Get("storeId"); got != testStoreID {
+			t.Errorf("unexpected store id: %s", got)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"status": "PAID",
+			"id": "test_payment_id",
+			"transactionId": "test_transaction_id",
+			"storeId": "store-test",
+			"method": {
+				"type": "PaymentMethodCard",
+				"card": {"publisher": "SHINHAN", "number": "1234-****-****-5678"},
+				"approvalNumber": "test_approval_number",
+				"installment": {"month": 3, "isInterestFree": true}
+			},
+			"requestedAt": "2024-01-01T00:00:00Z",
+			"updatedAt": "2024-01-01T00:01:00Z",
+			"statusChangedAt": "2024-01-01T00:01:00Z",
+			"orderName": "test_order_name",
+			"amount": {"total": 1000, "taxFree": 0, "vat": 91, "paid": 1000},
+			"currency": "KRW",
+			"customer": {"id": "test_customer_id"},
+			"paidAt": "2024-01-01T00:01:00Z",
+			"pgTxId": "test_pg_tx_id",
+			"receiptUrl": "test_receipt_url"
+		}`))
+	})
+
+	payment, err := client.GetPayment(context.Background(), v2.GetPaymentRequest{PaymentID: "test_payment_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paidAt := time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)
+	want := &v2.PaidPayment{
+		PaymentBase: v2.PaymentBase{
+			Status:        v2.PaymentStatusPaid,
+			ID:            "test_payment_id",
+			TransactionID: "test_transaction_id",
+			StoreID:       testStoreID,
+			Method: &v2.PaymentMethod{
+				Type:           v2.PaymentMethodTypeCard,
+				Card:           &v2.Card{Publisher: "SHINHAN", Number: "1234-****-****-5678"},
+				ApprovalNumber: "test_approval_number",
+				Installment:    &v2.PaymentInstallment{Month: 3, IsInterestFree: true},
+			},
+			RequestedAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:       paidAt,
+			StatusChangedAt: paidAt,
+			OrderName:       "test_order_name",
+			Amount:          v2.PaymentAmount{Total: 1000, Vat: 91, Paid: 1000},
+			Currency:        v2.CurrencyKRW,
+			Customer:        v2.Customer{ID: "test_customer_id"},
+		},
+		PaidAt:     paidAt,
+		PgTxID:     "test_pg_tx_id",
+		ReceiptURL: "test_receipt_url",
+	}
+
+	if diff := cmp.Diff(want, payment); diff != "" {
+		t.Errorf("unexpected payment (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnmarshalPayment(t *testing.T) {
+	tests := []struct {
+		data string
+		want v2.Payment
+	}{
+		{data: `{"status": "READY"}`, want: &v2.ReadyPayment{}},
+		{data: `{"status": "PAID"}`, want: &v2.PaidPayment{}},
+		{data: `{"status": "FAILED"}`, want: &v2.FailedPayment{}},
+		{data: `{"status": "CANCELLED"}`, want: &v2.CancelledPayment{}},
+		{data: `{"status": "PARTIAL_CANCELLED"}`, want: &v2.PartialCancelledPayment{}},
+		{data: `{"status": "VIRTUAL_ACCOUNT_ISSUED"}`, want: &v2.VirtualAccountIssuedPayment{}},
+		{data: `{"status": "PAY_PENDING"}`, want: &v2.UnrecognizedPayment{}},
+	}
+
+	for _, tt := range tests {
+		got, err := v2.UnmarshalPayment([]byte(tt.data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if gotType, wantType := typeName(got), typeName(tt.want); gotType != wantType {
+			t.Errorf("unexpected type for %s: got %s, want %s", tt.data, gotType, wantType)
+		}
+	}
+
+	p, err := v2.UnmarshalPayment([]byte(`{"status": "PAY_PENDING", "id": "test_payment_id"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unrecognized := p.(*v2.UnrecognizedPayment)
+	if unrecognized.Common().ID != "test_payment_id" || len(unrecognized.Raw) == 0 {
+		t.Errorf("unexpected unrecognized payment: %+v", unrecognized)
+	}
+}
+
+func typeName(v any) string {
+	return fmt.Sprintf("%T", v)
+}
+
+func TestSearchPayments(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.SearchPaymentsRequest
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("requestBody")), &body); err != nil {
+			t.Errorf("unexpected request body: %v", err)
+		}
+
+		want := v2.SearchPaymentsRequest{
+			Page: &v2.PageInput{Number: 1, Size: 2},
+			Filter: &v2.PaymentFilter{
+				StoreID: testStoreID,
+				Status:  []v2.PaymentStatus{v2.PaymentStatusPaid, v2.PaymentStatusFailed},
+			},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected request body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"items": [
+				{"status": "PAID", "id": "paid_payment_id"},
+				{"status": "FAILED", "id": "failed_payment_id", "failure": {"reason": "test_reason"}}
+			],
+			"page": {"number": 1, "size": 2, "totalCount": 5}
+		}`))
+	})
+
+	resp, err := client.SearchPayments(context.Background(), v2.SearchPaymentsRequest{
+		Page:   &v2.PageInput{Number: 1, Size: 2},
+		Filter: &v2.PaymentFilter{Status: []v2.PaymentStatus{v2.PaymentStatusPaid, v2.PaymentStatusFailed}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Items) != 2 {
+		t.Fatalf("unexpected number of items: %d", len(resp.Items))
+	}
+
+	if failed, ok := resp.Items[1].(*v2.FailedPayment); !ok || failed.Failure.Reason != "test_reason" {
+		t.Errorf("unexpected second item: %#v", resp.Items[1])
+	}
+
+	if !resp.Page.HasNext() {
+		t.Error("expected a next page")
+	}
+}
+
+func TestCancelPayment(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/test_payment_id/cancel", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"storeId":                  testStoreID,
+			"amount":                   float64(300),
+			"reason":                   "test_reason",
+			"currentCancellableAmount": float64(1000),
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"cancellation": {
+				"status": "SUCCEEDED",
+				"id": "test_cancellation_id",
+				"totalAmount": 300,
+				"taxFreeAmount": 0,
+				"vatAmount": 27,
+				"reason": "test_reason",
+				"requestedAt": "2024-01-02T00:00:00Z"
+			}
+		}`))
+	})
+
+	amount, cancellable := int64(300), int64(1000)
+	resp, err := client.CancelPayment(context.Background(), v2.CancelPaymentRequest{
+		PaymentID:                "test_payment_id",
+		Amount:                   &amount,
+		Reason:                   "test_reason",
+		CurrentCancellableAmount: &cancellable,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.PaymentCancellation{
+		Status:      v2.PaymentCancellationStatusSucceeded,
+		ID:          "test_cancellation_id",
+		TotalAmount: 300,
+		VatAmount:   27,
+		Reason:      "test_reason",
+		RequestedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	if diff := cmp.Diff(want, resp.Cancellation); diff != "" {
+		t.Errorf("unexpected cancellation (-want +got):\n%s", diff)
+	}
+}
+
+func TestCancelPaymentInconsistentAmount(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/test_payment_id/cancel", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"type": "CANCELLABLE_AMOUNT_CONSISTENCY_BROKEN", "message": "test_message"}`))
+	})
+
+	cancellable := int64(1000)
+	_, err := client.CancelPayment(context.Background(), v2.CancelPaymentRequest{
+		PaymentID:                "test_payment_id",
+		Reason:                   "test_reason",
+		CurrentCancellableAmount: &cancellable,
+	})
+
+	if !v2.IsErrorType(err, v2.ErrorTypeCancellableAmountConsistency) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPreRegisterPayment(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/test_payment_id/pre-register", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"storeId":     testStoreID,
+			"totalAmount": float64(1000),
+			"currency":    "KRW",
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	err := client.PreRegisterPayment(context.Background(), v2.PreRegisterPaymentRequest{
+		PaymentID:   "test_payment_id",
+		TotalAmount: 1000,
+		Currency:    v2.CurrencyKRW,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPayInstantly(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payments/test_payment_id/instant", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.PayInstantlyRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if body.Method.Card == nil || body.Method.Card.Credential.Number != "1234567812345678" {
+			t.Errorf("unexpected method: %+v", body.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"payment": {"transactionId": "test_transaction_id", "pgTxId": "test_pg_tx_id", "paidAt": "2024-01-01T00:00:01Z"}}`))
+	})
+
+	resp, err := client.PayInstantly(context.Background(), v2.PayInstantlyRequest{
+		PaymentID: "test_payment_id",
+		Method: v2.InstantPaymentMethodInput{
+			Card: &v2.CardMethodInput{Credential: v2.CardCredential{Number: "1234567812345678", ExpiryYear: "29", ExpiryMonth: "12"}},
+		},
+		OrderName: "test_order_name",
+		Amount:    v2.PaymentAmountInput{Total: 1000},
+		Currency:  v2.CurrencyKRW,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.BillingKeyPaymentSummary{
+		TransactionID: "test_transaction_id",
+		PgTxID:        "test_pg_tx_id",
+		PaidAt:        time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC),
+	}
+
+	if diff := cmp.Diff(want, resp.Payment); diff != "" {
+		t.Errorf("unexpected payment (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,237 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// PlatformPayoutMethod is the way payouts are transferred to the partners.
+type PlatformPayoutMethod string
+
+const (
+	// PlatformPayoutMethodDirect transfers from the account of the platform.
+	PlatformPayoutMethodDirect PlatformPayoutMethod = "DIRECT"
+	// PlatformPayoutMethodAgency transfers through the account of PortOne.
+	PlatformPayoutMethodAgency PlatformPayoutMethod = "AGENCY"
+)
+
+// PlatformBulkPayoutStatus is the status of a bulk payout.
+type PlatformBulkPayoutStatus string
+
+const (
+	PlatformBulkPayoutStatusPreparing        PlatformBulkPayoutStatus = "PREPARING"
+	PlatformBulkPayoutStatusPrepared         PlatformBulkPayoutStatus = "PREPARED"
+	PlatformBulkPayoutStatusOngoing          PlatformBulkPayoutStatus = "ONGOING"
+	PlatformBulkPayoutStatusCancelled        PlatformBulkPayoutStatus = "CANCELLED"
+	PlatformBulkPayoutStatusStopped          PlatformBulkPayoutStatus = "STOPPED"
+	PlatformBulkPayoutStatusCompleted        PlatformBulkPayoutStatus = "COMPLETED"
+	PlatformBulkPayoutStatusPartialCompleted PlatformBulkPayoutStatus = "PARTIAL_COMPLETED"
+)
+
+// PlatformPayoutStatus is the status of the payout to a single partner.
+type PlatformPayoutStatus string
+
+const (
+	PlatformPayoutStatusPrepared   PlatformPayoutStatus = "PREPARED"
+	PlatformPayoutStatusScheduled  PlatformPayoutStatus = "SCHEDULED"
+	PlatformPayoutStatusProcessing PlatformPayoutStatus = "PROCESSING"
+	PlatformPayoutStatusSucceeded  PlatformPayoutStatus = "SUCCEEDED"
+	PlatformPayoutStatusFailed     PlatformPayoutStatus = "FAILED"
+	PlatformPayoutStatusCancelled  PlatformPayoutStatus = "CANCELLED"
+	PlatformPayoutStatusStopped    PlatformPayoutStatus = "STOPPED"
+)
+
+// PlatformPayoutFailureReason is the reason a payout failed. The list is not exhaustive.
+type PlatformPayoutFailureReason string
+
+const (
+	PlatformPayoutFailureReasonInsufficientBalance PlatformPayoutFailureReason = "INSUFFICIENT_BALANCE"
+	PlatformPayoutFailureReasonInvalidAccount      PlatformPayoutFailureReason = "INVALID_ACCOUNT"
+	PlatformPayoutFailureReasonHolderMismatch      PlatformPayoutFailureReason = "ACCOUNT_HOLDER_MISMATCH"
+	PlatformPayoutFailureReasonBankMaintenance     PlatformPayoutFailureReason = "BANK_MAINTENANCE"
+	PlatformPayoutFailureReasonUnknown             PlatformPayoutFailureReason = "UNKNOWN"
+)
+
+// PlatformBulkPayout represents a batch of payouts to partners.
+type PlatformBulkPayout struct {
+	ID                string                   `json:"id"`
+	Name              string                   `json:"name"`
+	Method            PlatformPayoutMethod     `json:"method"`
+	Status            PlatformBulkPayoutStatus `json:"status"`
+	TotalPayoutAmount int64                    `json:"totalPayoutAmount"`
+	TotalPayoutCount  int                      `json:"totalPayoutCount"`
+	SucceededCount    int                      `json:"succeededCount"`
+	FailedCount       int                      `json:"failedCount"`
+	CreatedAt         time.Time                `json:"createdAt"`
+	StatusUpdatedAt   time.Time                `json:"statusUpdatedAt"`
+}
+
+// PlatformPayoutAccount is the bank account a payout is transferred to.
+type PlatformPayoutAccount struct {
+	Bank       Bank   `json:"bank"`
+	Number     string `json:"number"`
+	HolderName string `json:"holderName"`
+}
+
+// PlatformPayoutPartner identifies the partner of a payout.
+type PlatformPayoutPartner struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// PlatformPayout represents the payout of the settlements of a partner.
+type PlatformPayout struct {
+	ID           string                `json:"id"`
+	BulkPayoutID string                `json:"bulkPayoutId"`
+	Partner      PlatformPayoutPartner `json:"partner"`
+	Status       PlatformPayoutStatus  `json:"status"`
+	Memo         string                `json:"memo,omitempty"`
+	Currency     Currency              `json:"currency"`
+	Amount       int64                 `json:"amount"`
+	// DepositMemo is the text shown in the bank statement of the partner.
+	DepositMemo string                `json:"depositMemo,omitempty"`
+	Account     PlatformPayoutAccount `json:"account"`
+	// FailureReason is only set for failed payouts.
+	FailureReason   PlatformPayoutFailureReason `json:"failureReason,omitempty"`
+	CreatedAt       time.Time                   `json:"createdAt"`
+	StatusUpdatedAt time.Time                   `json:"statusUpdatedAt"`
+}
+
+// CreatePlatformBulkPayoutRequest represents a request for 'POST /platform/bulk-payouts'.
+// The payouts are prepared from the settled transfers of the partners and must be started from the console.
+type CreatePlatformBulkPayoutRequest struct {
+	Name   string               `json:"name"`
+	Method PlatformPayoutMethod `json:"method"`
+	// PartnerIDs restricts the payouts to the given partners. Every partner with settled transfers is paid when empty.
+	PartnerIDs []string `json:"partnerIds,omitempty"`
+	// WithdrawalAccountID is the account of the platform payouts are made from. It is required for direct payouts.
+	WithdrawalAccountID string `json:"withdrawalAccountId,omitempty"`
+	DepositMemo         string `json:"depositMemo,omitempty"`
+	Memo                string `json:"memo,omitempty"`
+}
+
+// CreatePlatformBulkPayoutResponse represents a response of 'POST /platform/bulk-payouts'.
+type CreatePlatformBulkPayoutResponse struct {
+	BulkPayout PlatformBulkPayout `json:"bulkPayout"`
+}
+
+// CreatePlatformBulkPayout prepares the payouts of the settled transfers.
+func (ps *platformService) CreatePlatformBulkPayout(ctx context.Context, req CreatePlatformBulkPayoutRequest) (CreatePlatformBulkPayoutResponse, error) {
+	var resp CreatePlatformBulkPayoutResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath("/bulk-payouts"), req, &resp)
+	if err != nil {
+		return CreatePlatformBulkPayoutResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// PlatformTimestampRange is a range of instants, From inclusive and Until exclusive.
+type PlatformTimestampRange struct {
+	From  time.Time `json:"from"`
+	Until time.Time `json:"until"`
+}
+
+// PlatformBulkPayoutFilter restricts a bulk payout search. Zero fields are ignored.
+type PlatformBulkPayoutFilter struct {
+	// CreatedAt applies to the creation time of the bulk payouts.
+	CreatedAt *PlatformTimestampRange    `json:"createdAt,omitempty"`
+	Statuses  []PlatformBulkPayoutStatus `json:"statuses,omitempty"`
+	Methods   []PlatformPayoutMethod     `json:"methods,omitempty"`
+	Keyword   string                     `json:"keyword,omitempty"`
+}
+
+// ListPlatformBulkPayoutsRequest represents a request for 'GET /platform/bulk-payouts'.
+type ListPlatformBulkPayoutsRequest struct {
+	Page   *PageInput                `json:"page,omitempty"`
+	Filter *PlatformBulkPayoutFilter `json:"filter,omitempty"`
+}
+
+// ListPlatformBulkPayoutsResponse represents a response of 'GET /platform/bulk-payouts'.
+type ListPlatformBulkPayoutsResponse struct {
+	Items []PlatformBulkPayout `json:"items"`
+	Page  PageInfo             `json:"page"`
+}
+
+// ListPlatformBulkPayouts returns the bulk payouts matching the filter, one page at a time.
+func (ps *platformService) ListPlatformBulkPayouts(ctx context.Context, req ListPlatformBulkPayoutsRequest) (ListPlatformBulkPayoutsResponse, error) {
+	var resp ListPlatformBulkPayoutsResponse
+	err := ps.search(ctx, ps.baseURL.JoinPath("/bulk-payouts"), req, &resp)
+	if err != nil {
+		return ListPlatformBulkPayoutsResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// PlatformPayoutFilter restricts a payout search. Zero fields are ignored.
+type PlatformPayoutFilter struct {
+	// CreatedAt applies to the creation time of the payouts.
+	CreatedAt    *PlatformTimestampRange `json:"createdAt,omitempty"`
+	BulkPayoutID string                  `json:"bulkPayoutId,omitempty"`
+	PartnerIDs   []string                `json:"partnerIds,omitempty"`
+	Statuses     []PlatformPayoutStatus  `json:"statuses,omitempty"`
+}
+
+// ListPlatformPayoutsRequest represents a request for 'GET /platform/payouts'.
+type ListPlatformPayoutsRequest struct {
+	Page   *PageInput            `json:"page,omitempty"`
+	Filter *PlatformPayoutFilter `json:"filter,omitempty"`
+}
+
+// ListPlatformPayoutsResponse represents a response of 'GET /platform/payouts'.
+type ListPlatformPayoutsResponse struct {
+	Items []PlatformPayout `json:"items"`
+	Page  PageInfo         `json:"page"`
+}
+
+// ListPlatformPayouts returns the payouts matching the filter, one page at a time.
+func (ps *platformService) ListPlatformPayouts(ctx context.Context, req ListPlatformPayoutsRequest) (ListPlatformPayoutsResponse, error) {
+	var resp ListPlatformPayoutsResponse
+	err := ps.search(ctx, ps.baseURL.JoinPath("/payouts"), req, &resp)
+	if err != nil {
+		return ListPlatformPayoutsResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetPlatformAccountHolderRequest represents a request for 'GET /platform/bank-accounts/{bank}/{accountNumber}/holder'.
+// Birthdate (YYMMDD) identifies individuals and BusinessRegistrationNumber identifies businesses; one of them must be set.
+type GetPlatformAccountHolderRequest struct {
+	Bank                       Bank
+	AccountNumber              string
+	Birthdate                  string
+	BusinessRegistrationNumber string
+}
+
+// GetPlatformAccountHolderResponse represents a response of 'GET /platform/bank-accounts/{bank}/{accountNumber}/holder'.
+type GetPlatformAccountHolderResponse struct {
+	HolderName string `json:"holderName"`
+	// AccountVerificationID can be passed to PlatformAccountInput to register the verified account.
+	AccountVerificationID string `json:"accountVerificationId"`
+}
+
+// GetPlatformAccountHolder verifies a bank account and returns the name of its holder.
+func (ps *platformService) GetPlatformAccountHolder(ctx context.Context, req GetPlatformAccountHolderRequest) (GetPlatformAccountHolderResponse, error) {
+	u := ps.baseURL.JoinPath("/bank-accounts", string(req.Bank), req.AccountNumber, "/holder")
+
+	q := url.Values{}
+	if req.Birthdate != "" {
+		q.Set("birthdate", req.Birthdate)
+	}
+	if req.BusinessRegistrationNumber != "" {
+		q.Set("businessRegistrationNumber", req.BusinessRegistrationNumber)
+	}
+	u.RawQuery = q.Encode()
+
+	var resp GetPlatformAccountHolderResponse
+	err := ps.send(ctx, http.MethodGet, u, nil, &resp)
+	if err != nil {
+		return GetPlatformAccountHolderResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,165 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreatePlatformBulkPayout(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/bulk-payouts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"name":       "2024-01 payouts",
+			"method":     "AGENCY",
+			"partnerIds": []any{"test_partner_id"},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"bulkPayout": {
+			"id": "test_bulk_payout_id",
+			"name": "2024-01 payouts",
+			"method": "AGENCY",
+			"status": "PREPARED",
+			"totalPayoutAmount": 88200,
+			"totalPayoutCount": 1,
+			"createdAt": "2024-02-01T00:00:00Z",
+			"statusUpdatedAt": "2024-02-01T00:00:00Z"
+		}}`))
+	})
+
+	resp, err := client.CreatePlatformBulkPayout(context.Background(), v2.CreatePlatformBulkPayoutRequest{
+		Name:       "2024-01 payouts",
+		Method:     v2.PlatformPayoutMethodAgency,
+		PartnerIDs: []string{"test_partner_id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createdAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	want := v2.PlatformBulkPayout{
+		ID:                "test_bulk_payout_id",
+		Name:              "2024-01 payouts",
+		Method:            v2.PlatformPayoutMethodAgency,
+		Status:            v2.PlatformBulkPayoutStatusPrepared,
+		TotalPayoutAmount: 88200,
+		TotalPayoutCount:  1,
+		CreatedAt:         createdAt,
+		StatusUpdatedAt:   createdAt,
+	}
+	if diff := cmp.Diff(want, resp.BulkPayout); diff != "" {
+		t.Errorf("unexpected bulk payout (-want +got):\n%s", diff)
+	}
+}
+
+func TestListPlatformPayouts(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/payouts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.ListPlatformPayoutsRequest
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("requestBody")), &body); err != nil {
+			t.Errorf("unexpected request body: %v", err)
+		}
+
+		want := v2.ListPlatformPayoutsRequest{
+			Filter: &v2.PlatformPayoutFilter{
+				BulkPayoutID: "test_bulk_payout_id",
+				Statuses:     []v2.PlatformPayoutStatus{v2.PlatformPayoutStatusFailed},
+			},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected request body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"items": [{
+				"id": "test_payout_id",
+				"bulkPayoutId": "test_bulk_payout_id",
+				"partner": {"id": "test_partner_id", "name": "test_partner"},
+				"status": "FAILED",
+				"currency": "KRW",
+				"amount": 88200,
+				"account": {"bank": "KOOKMIN", "number": "1234567890", "holderName": "홍길동"},
+				"failureReason": "INVALID_ACCOUNT"
+			}],
+			"page": {"number": 0, "size": 10, "totalCount": 1}
+		}`))
+	})
+
+	resp, err := client.ListPlatformPayouts(context.Background(), v2.ListPlatformPayoutsRequest{
+		Filter: &v2.PlatformPayoutFilter{
+			BulkPayoutID: "test_bulk_payout_id",
+			Statuses:     []v2.PlatformPayoutStatus{v2.PlatformPayoutStatusFailed},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Items) != 1 {
+		t.Fatalf("unexpected number of payouts: %d", len(resp.Items))
+	}
+	if got := resp.Items[0]; got.FailureReason != v2.PlatformPayoutFailureReasonInvalidAccount || got.Amount != 88200 || got.Partner.ID != "test_partner_id" {
+		t.Errorf("unexpected payout: %+v", got)
+	}
+}
+
+func TestGetPlatformAccountHolder(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/bank-accounts/KOOKMIN/1234567890/holder", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+		if got := r.URL.Query().Get("birthdate"); got != "900517" {
+			t.Errorf("unexpected birthdate: %s", got)
+		}
+		if r.URL.Query().Has("businessRegistrationNumber") {
+			t.Errorf("unexpected business registration number")
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"holderName": "홍길동", "accountVerificationId": "test_verification_id"}`))
+	})
+
+	resp, err := client.GetPlatformAccountHolder(context.Background(), v2.GetPlatformAccountHolderRequest{
+		Bank:          "KOOKMIN",
+		AccountNumber: "1234567890",
+		Birthdate:     "900517",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.GetPlatformAccountHolderResponse{HolderName: "홍길동", AccountVerificationID: "test_verification_id"}
+	if diff := cmp.Diff(want, resp); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
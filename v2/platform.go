@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// platformService groups the PortOne Platform APIs, which settle the revenue of a marketplace to its partners.
+// Platform resources belong to the platform of the API secret rather than to a store.
+type platformService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+}
+
+func newPlatformService(baseURL *url.URL, httpClient *http.Client) *platformService {
+	return &platformService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (ps *platformService) send(ctx context.Context, method string, u *url.URL, body, respBody any) error {
+	httpReq, err := newRequest(ctx, method, u.String(), body)
+	if err != nil {
+		return err
+	}
+
+	return do(ps.httpClient, httpReq, respBody)
+}
+
+func (ps *platformService) search(ctx context.Context, u *url.URL, req, respBody any) error {
+	if err := setRequestBodyQuery(u, req); err != nil {
+		return err
+	}
+
+	return ps.send(ctx, http.MethodGet, u, nil, respBody)
+}
@@ -0,0 +1,181 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type paymentSchedulesService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	storeID    string
+}
+
+func newPaymentSchedulesService(baseURL *url.URL, httpClient *http.Client, storeID string) *paymentSchedulesService {
+	return &paymentSchedulesService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		storeID:    storeID,
+	}
+}
+
+// PaymentScheduleStatus is the status of a payment schedule.
+type PaymentScheduleStatus string
+
+const (
+	// PaymentScheduleStatusScheduled is a schedule waiting for its time to pay.
+	PaymentScheduleStatusScheduled PaymentScheduleStatus = "SCHEDULED"
+	// PaymentScheduleStatusStarted is a schedule whose payment is being processed.
+	PaymentScheduleStatusStarted PaymentScheduleStatus = "STARTED"
+	// PaymentScheduleStatusSucceeded is a schedule whose payment succeeded.
+	PaymentScheduleStatusSucceeded PaymentScheduleStatus = "SUCCEEDED"
+	// PaymentScheduleStatusFailed is a schedule whose payment failed.
+	PaymentScheduleStatusFailed PaymentScheduleStatus = "FAILED"
+	// PaymentScheduleStatusRevoked is a schedule revoked before its time to pay.
+	PaymentScheduleStatusRevoked PaymentScheduleStatus = "REVOKED"
+	// PaymentScheduleStatusPending is a schedule whose payment awaits a confirmation from the PG.
+	PaymentScheduleStatusPending PaymentScheduleStatus = "PENDING"
+)
+
+// PaymentSchedule represents a payment scheduled on a billing key.
+// The timestamps which do not apply to the status of the schedule are nil.
+type PaymentSchedule struct {
+	Status           PaymentScheduleStatus `json:"status"`
+	ID               string                `json:"id"`
+	MerchantID       string                `json:"merchantId"`
+	StoreID          string                `json:"storeId"`
+	PaymentID        string                `json:"paymentId"`
+	BillingKey       string                `json:"billingKey"`
+	OrderName        string                `json:"orderName"`
+	Customer         Customer              `json:"customer"`
+	CustomData       string                `json:"customData,omitempty"`
+	TotalAmount      int64                 `json:"totalAmount"`
+	TaxFreeAmount    int64                 `json:"taxFreeAmount,omitempty"`
+	VatAmount        int64                 `json:"vatAmount,omitempty"`
+	Currency         Currency              `json:"currency"`
+	InstallmentMonth int                   `json:"installmentMonth,omitempty"`
+	NoticeURLs       []string              `json:"noticeUrls,omitempty"`
+	CreatedAt        time.Time             `json:"createdAt"`
+	TimeToPay        time.Time             `json:"timeToPay"`
+	StartedAt        *time.Time            `json:"startedAt,omitempty"`
+	CompletedAt      *time.Time            `json:"completedAt,omitempty"`
+	RevokedAt        *time.Time            `json:"revokedAt,omitempty"`
+}
+
+// CreatePaymentScheduleRequest represents a request for 'POST /payments/{paymentId}/schedule'.
+type CreatePaymentScheduleRequest struct {
+	PaymentID string                   `json:"-"`
+	Payment   PayWithBillingKeyRequest `json:"payment"`
+	TimeToPay time.Time                `json:"timeToPay"`
+}
+
+// CreatePaymentScheduleResponse represents a response of 'POST /payments/{paymentId}/schedule'.
+type CreatePaymentScheduleResponse struct {
+	Schedule struct {
+		ID string `json:"id"`
+	} `json:"schedule"`
+}
+
+// CreatePaymentSchedule schedules a payment on a billing key.
+func (ss *paymentSchedulesService) CreatePaymentSchedule(ctx context.Context, req CreatePaymentScheduleRequest) (CreatePaymentScheduleResponse, error) {
+	req.Payment.StoreID = orDefault(req.Payment.StoreID, ss.storeID)
+
+	u := ss.baseURL.JoinPath("/payments", req.PaymentID, "/schedule")
+	httpReq, err := newRequest(ctx, http.MethodPost, u.String(), req)
+	if err != nil {
+		return CreatePaymentScheduleResponse{}, err
+	}
+
+	var resp CreatePaymentScheduleResponse
+	err = do(ss.httpClient, httpReq, &resp)
+	if err != nil {
+		return CreatePaymentScheduleResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// PaymentScheduleFilter restricts a payment schedule search. Zero fields are ignored.
+// From and Until apply to the time to pay of the schedules.
+type PaymentScheduleFilter struct {
+	StoreID    string                  `json:"storeId,omitempty"`
+	BillingKey string                  `json:"billingKey,omitempty"`
+	From       *time.Time              `json:"from,omitempty"`
+	Until      *time.Time              `json:"until,omitempty"`
+	Status     []PaymentScheduleStatus `json:"status,omitempty"`
+}
+
+// SearchPaymentSchedulesRequest represents a request for 'GET /payment-schedules'.
+type SearchPaymentSchedulesRequest struct {
+	Page   *PageInput             `json:"page,omitempty"`
+	Filter *PaymentScheduleFilter `json:"filter,omitempty"`
+}
+
+// SearchPaymentSchedulesResponse represents a response of 'GET /payment-schedules'.
+type SearchPaymentSchedulesResponse struct {
+	Items []PaymentSchedule `json:"items"`
+	Page  PageInfo          `json:"page"`
+}
+
+// SearchPaymentSchedules returns the payment schedules matching the filter, one page at a time.
+func (ss *paymentSchedulesService) SearchPaymentSchedules(ctx context.Context, req SearchPaymentSchedulesRequest) (SearchPaymentSchedulesResponse, error) {
+	filter := PaymentScheduleFilter{}
+	if req.Filter != nil {
+		filter = *req.Filter
+	}
+	filter.StoreID = orDefault(filter.StoreID, ss.storeID)
+	req.Filter = &filter
+
+	u := ss.baseURL.JoinPath("/payment-schedules")
+	if err := setRequestBodyQuery(u, req); err != nil {
+		return SearchPaymentSchedulesResponse{}, err
+	}
+
+	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return SearchPaymentSchedulesResponse{}, err
+	}
+
+	var resp SearchPaymentSchedulesResponse
+	err = do(ss.httpClient, httpReq, &resp)
+	if err != nil {
+		return SearchPaymentSchedulesResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// RevokePaymentSchedulesRequest represents a request for 'DELETE /payment-schedules'.
+// Either BillingKey, to revoke every schedule of a billing key, or ScheduleIDs must be set.
+type RevokePaymentSchedulesRequest struct {
+	StoreID     string   `json:"storeId,omitempty"`
+	BillingKey  string   `json:"billingKey,omitempty"`
+	ScheduleIDs []string `json:"scheduleIds,omitempty"`
+}
+
+// RevokePaymentSchedulesResponse represents a response of 'DELETE /payment-schedules'.
+type RevokePaymentSchedulesResponse struct {
+	RevokedScheduleIDs []string   `json:"revokedScheduleIds"`
+	RevokedAt          *time.Time `json:"revokedAt,omitempty"`
+}
+
+// RevokePaymentSchedules revokes payment schedules which have not started yet.
+func (ss *paymentSchedulesService) RevokePaymentSchedules(ctx context.Context, req RevokePaymentSchedulesRequest) (RevokePaymentSchedulesResponse, error) {
+	req.StoreID = orDefault(req.StoreID, ss.storeID)
+
+	u := ss.baseURL.JoinPath("/payment-schedules")
+	httpReq, err := newRequest(ctx, http.MethodDelete, u.String(), req)
+	if err != nil {
+		return RevokePaymentSchedulesResponse{}, err
+	}
+
+	var resp RevokePaymentSchedulesResponse
+	err = do(ss.httpClient, httpReq, &resp)
+	if err != nil {
+		return RevokePaymentSchedulesResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,177 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreatePaymentSchedule(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	timeToPay := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/payments/test_payment_id/schedule", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.CreatePaymentScheduleRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if body.Payment.StoreID != testStoreID || body.Payment.BillingKey != "test_billing_key" || !body.TimeToPay.Equal(timeToPay) {
+			t.Errorf("unexpected body: %+v", body)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"schedule": {"id": "test_schedule_id"}}`))
+	})
+
+	resp, err := client.CreatePaymentSchedule(context.Background(), v2.CreatePaymentScheduleRequest{
+		PaymentID: "test_payment_id",
+		Payment: v2.PayWithBillingKeyRequest{
+			BillingKey: "test_billing_key",
+			OrderName:  "test_order_name",
+			Amount:     v2.PaymentAmountInput{Total: 9900},
+			Currency:   v2.CurrencyKRW,
+		},
+		TimeToPay: timeToPay,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Schedule.ID != "test_schedule_id" {
+		t.Errorf("unexpected schedule id: %s", resp.Schedule.ID)
+	}
+}
+
+func TestSearchPaymentSchedules(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/payment-schedules", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.SearchPaymentSchedulesRequest
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("requestBody")), &body); err != nil {
+			t.Errorf("unexpected request body: %v", err)
+		}
+
+		want := v2.SearchPaymentSchedulesRequest{
+			Page: &v2.PageInput{Size: 10},
+			Filter: &v2.PaymentScheduleFilter{
+				StoreID:    testStoreID,
+				BillingKey: "test_billing_key",
+				From:       &from,
+				Until:      &until,
+				Status:     []v2.PaymentScheduleStatus{v2.PaymentScheduleStatusScheduled},
+			},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected request body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"items": [
+				{
+					"status": "SCHEDULED",
+					"id": "test_schedule_id",
+					"storeId": "store-test",
+					"paymentId": "test_payment_id",
+					"billingKey": "test_billing_key",
+					"orderName": "test_order_name",
+					"totalAmount": 9900,
+					"currency": "KRW",
+					"createdAt": "2024-01-01T00:00:00Z",
+					"timeToPay": "2024-02-01T00:00:00Z"
+				}
+			],
+			"page": {"number": 0, "size": 10, "totalCount": 1}
+		}`))
+	})
+
+	resp, err := client.SearchPaymentSchedules(context.Background(), v2.SearchPaymentSchedulesRequest{
+		Page: &v2.PageInput{Size: 10},
+		Filter: &v2.PaymentScheduleFilter{
+			BillingKey: "test_billing_key",
+			From:       &from,
+			Until:      &until,
+			Status:     []v2.PaymentScheduleStatus{v2.PaymentScheduleStatusScheduled},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []v2.PaymentSchedule{
+		{
+			Status:      v2.PaymentScheduleStatusScheduled,
+			ID:          "test_schedule_id",
+			StoreID:     testStoreID,
+			PaymentID:   "test_payment_id",
+			BillingKey:  "test_billing_key",
+			OrderName:   "test_order_name",
+			TotalAmount: 9900,
+			Currency:    v2.CurrencyKRW,
+			CreatedAt:   from,
+			TimeToPay:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	if diff := cmp.Diff(want, resp.Items); diff != "" {
+		t.Errorf("unexpected schedules (-want +got):\n%s", diff)
+	}
+}
+
+func TestRevokePaymentSchedules(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/payment-schedules", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.RevokePaymentSchedulesRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := v2.RevokePaymentSchedulesRequest{
+			StoreID:     testStoreID,
+			ScheduleIDs: []string{"test_schedule_id"},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"revokedScheduleIds": ["test_schedule_id"], "revokedAt": "2024-01-15T00:00:00Z"}`))
+	})
+
+	resp, err := client.RevokePaymentSchedules(context.Background(), v2.RevokePaymentSchedulesRequest{
+		ScheduleIDs: []string{"test_schedule_id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"test_schedule_id"}, resp.RevokedScheduleIDs); diff != "" {
+		t.Errorf("unexpected revoked schedules (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,301 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// b2bService groups the B2B APIs, which issue electronic tax invoices between businesses.
+// B2B resources belong to the businesses registered to the API secret rather than to a store.
+type b2bService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+}
+
+func newB2BService(baseURL *url.URL, httpClient *http.Client) *b2bService {
+	return &b2bService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+	}
+}
+
+// B2BTaxInvoiceStatus is the status of a tax invoice.
+type B2BTaxInvoiceStatus string
+
+const (
+	B2BTaxInvoiceStatusDrafted                     B2BTaxInvoiceStatus = "DRAFTED"
+	B2BTaxInvoiceStatusRequested                   B2BTaxInvoiceStatus = "REQUESTED"
+	B2BTaxInvoiceStatusRequestCancelled            B2BTaxInvoiceStatus = "REQUEST_CANCELLED"
+	B2BTaxInvoiceStatusIssued                      B2BTaxInvoiceStatus = "ISSUED"
+	B2BTaxInvoiceStatusIssuanceCancelled           B2BTaxInvoiceStatus = "ISSUANCE_CANCELLED"
+	B2BTaxInvoiceStatusRequestRefused              B2BTaxInvoiceStatus = "REQUEST_REFUSED"
+	B2BTaxInvoiceStatusSending                     B2BTaxInvoiceStatus = "SENDING"
+	B2BTaxInvoiceStatusSendingCompleted            B2BTaxInvoiceStatus = "SENDING_COMPLETED"
+	B2BTaxInvoiceStatusSendingFailed               B2BTaxInvoiceStatus = "SENDING_FAILED"
+	B2BTaxInvoiceStatusIssuanceCancelledBySupplier B2BTaxInvoiceStatus = "ISSUANCE_CANCELLED_BY_SUPPLIER"
+)
+
+// B2BTaxInvoicePurposeType tells whether a tax invoice proves a payment or claims one.
+type B2BTaxInvoicePurposeType string
+
+const (
+	// B2BTaxInvoicePurposeTypeReceipt proves a payment already made (영수).
+	B2BTaxInvoicePurposeTypeReceipt B2BTaxInvoicePurposeType = "RECEIPT"
+	// B2BTaxInvoicePurposeTypeInvoice claims a payment (청구).
+	B2BTaxInvoicePurposeTypeInvoice B2BTaxInvoicePurposeType = "INVOICE"
+	B2BTaxInvoicePurposeTypeNone    B2BTaxInvoicePurposeType = "NONE"
+)
+
+// B2BTaxType is the taxation of a tax invoice.
+type B2BTaxType string
+
+const (
+	B2BTaxTypeTaxable   B2BTaxType = "TAXABLE"
+	B2BTaxTypeZeroRated B2BTaxType = "ZERO_RATED"
+	B2BTaxTypeTaxFree   B2BTaxType = "TAX_FREE"
+)
+
+// B2BTaxInvoiceDocumentKeyType tells whose key identifies a tax invoice.
+type B2BTaxInvoiceDocumentKeyType string
+
+const (
+	B2BTaxInvoiceDocumentKeyTypeSupplier  B2BTaxInvoiceDocumentKeyType = "SUPPLIER"
+	B2BTaxInvoiceDocumentKeyTypeRecipient B2BTaxInvoiceDocumentKeyType = "RECIPIENT"
+)
+
+// B2BContact is the person in charge of the tax invoices of a company.
+type B2BContact struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Department  string `json:"department,omitempty"`
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+	Email       string `json:"email"`
+}
+
+// B2BCompany is the supplier or the recipient of a tax invoice.
+type B2BCompany struct {
+	// BRN is the business registration number (사업자등록번호), without dashes.
+	BRN string `json:"brn"`
+	// TaxRegistrationID is the sub business place number (종사업장번호), if any.
+	TaxRegistrationID  string     `json:"taxRegistrationId,omitempty"`
+	Name               string     `json:"name"`
+	RepresentativeName string     `json:"representativeName"`
+	Address            string     `json:"address,omitempty"`
+	BusinessType       string     `json:"businessType,omitempty"`
+	BusinessClass      string     `json:"businessClass,omitempty"`
+	Contact            B2BContact `json:"contact"`
+}
+
+// B2BTaxInvoiceItem is a line of a tax invoice.
+type B2BTaxInvoiceItem struct {
+	// PurchaseDate is formatted as YYYYMMDD.
+	PurchaseDate     string `json:"purchaseDate,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Spec             string `json:"spec,omitempty"`
+	Quantity         int64  `json:"quantity,omitempty"`
+	UnitCostAmount   int64  `json:"unitCostAmount,omitempty"`
+	SupplyCostAmount int64  `json:"supplyCostAmount,omitempty"`
+	TaxAmount        int64  `json:"taxAmount,omitempty"`
+	Remark           string `json:"remark,omitempty"`
+}
+
+// B2BTaxInvoiceInput is the content of a tax invoice to issue or to request.
+type B2BTaxInvoiceInput struct {
+	// WriteDate is formatted as YYYYMMDD.
+	WriteDate         string                   `json:"writeDate"`
+	PurposeType       B2BTaxInvoicePurposeType `json:"purposeType"`
+	TaxType           B2BTaxType               `json:"taxType"`
+	TotalSupplyAmount int64                    `json:"totalSupplyAmount"`
+	TotalTaxAmount    int64                    `json:"totalTaxAmount"`
+	TotalAmount       int64                    `json:"totalAmount"`
+	SerialNumber      string                   `json:"serialNumber,omitempty"`
+	Remarks           []string                 `json:"remarks,omitempty"`
+	Supplier          B2BCompany               `json:"supplier"`
+	Recipient         B2BCompany               `json:"recipient"`
+	Items             []B2BTaxInvoiceItem      `json:"items,omitempty"`
+	// SupplierDocumentKey and RecipientDocumentKey identify the tax invoice for each side.
+	// They are generated by PortOne when empty.
+	SupplierDocumentKey  string `json:"supplierDocumentKey,omitempty"`
+	RecipientDocumentKey string `json:"recipientDocumentKey,omitempty"`
+}
+
+// B2BTaxInvoice represents an electronic tax invoice.
+type B2BTaxInvoice struct {
+	Status               B2BTaxInvoiceStatus      `json:"status"`
+	WriteDate            string                   `json:"writeDate"`
+	PurposeType          B2BTaxInvoicePurposeType `json:"purposeType"`
+	TaxType              B2BTaxType               `json:"taxType"`
+	TotalSupplyAmount    int64                    `json:"totalSupplyAmount"`
+	TotalTaxAmount       int64                    `json:"totalTaxAmount"`
+	TotalAmount          int64                    `json:"totalAmount"`
+	SerialNumber         string                   `json:"serialNumber,omitempty"`
+	Remarks              []string                 `json:"remarks,omitempty"`
+	Supplier             B2BCompany               `json:"supplier"`
+	Recipient            B2BCompany               `json:"recipient"`
+	Items                []B2BTaxInvoiceItem      `json:"items,omitempty"`
+	SupplierDocumentKey  string                   `json:"supplierDocumentKey,omitempty"`
+	RecipientDocumentKey string                   `json:"recipientDocumentKey,omitempty"`
+	// NtsApprovalNumber is the approval number of the National Tax Service, set once the invoice is sent to it.
+	NtsApprovalNumber string     `json:"ntsApprovalNumber,omitempty"`
+	IssuedAt          *time.Time `json:"issuedAt,omitempty"`
+	NtsSentAt         *time.Time `json:"ntsSentAt,omitempty"`
+}
+
+// B2BTaxInvoiceResponse represents a response of the endpoints returning a single tax invoice.
+type B2BTaxInvoiceResponse struct {
+	TaxInvoice B2BTaxInvoice `json:"taxInvoice"`
+}
+
+// IssueB2BTaxInvoiceRequest represents a request for 'POST /b2b/tax-invoices/register-issue'.
+type IssueB2BTaxInvoiceRequest struct {
+	TaxInvoice B2BTaxInvoiceInput `json:"taxInvoice"`
+	Memo       string             `json:"memo,omitempty"`
+}
+
+// IssueB2BTaxInvoice issues a tax invoice as its supplier (정발행).
+func (bs *b2bService) IssueB2BTaxInvoice(ctx context.Context, req IssueB2BTaxInvoiceRequest) (B2BTaxInvoiceResponse, error) {
+	return bs.doTaxInvoice(ctx, http.MethodPost, bs.baseURL.JoinPath("/tax-invoices/register-issue"), req)
+}
+
+// RequestB2BTaxInvoiceRequest represents a request for 'POST /b2b/tax-invoices/request-reverse-issuance'.
+type RequestB2BTaxInvoiceRequest struct {
+	TaxInvoice B2BTaxInvoiceInput `json:"taxInvoice"`
+	Memo       string             `json:"memo,omitempty"`
+}
+
+// RequestB2BTaxInvoice requests the supplier to issue a tax invoice, as its recipient (역발행 요청).
+func (bs *b2bService) RequestB2BTaxInvoice(ctx context.Context, req RequestB2BTaxInvoiceRequest) (B2BTaxInvoiceResponse, error) {
+	return bs.doTaxInvoice(ctx, http.MethodPost, bs.baseURL.JoinPath("/tax-invoices/request-reverse-issuance"), req)
+}
+
+// CancelB2BTaxInvoiceRequest represents a request for 'POST /b2b/tax-invoices/{documentKey}/cancel-issuance'.
+type CancelB2BTaxInvoiceRequest struct {
+	DocumentKey string `json:"-"`
+	// BRN is the business registration number of the supplier.
+	BRN             string                       `json:"brn"`
+	DocumentKeyType B2BTaxInvoiceDocumentKeyType `json:"documentKeyType,omitempty"`
+	Memo            string                       `json:"memo,omitempty"`
+}
+
+// CancelB2BTaxInvoice cancels an issued tax invoice before it is sent to the National Tax Service.
+func (bs *b2bService) CancelB2BTaxInvoice(ctx context.Context, req CancelB2BTaxInvoiceRequest) (B2BTaxInvoiceResponse, error) {
+	return bs.doTaxInvoice(ctx, http.MethodPost, bs.baseURL.JoinPath("/tax-invoices", req.DocumentKey, "/cancel-issuance"), req)
+}
+
+// GetB2BTaxInvoiceRequest represents a request for 'GET /b2b/tax-invoices/{documentKey}'.
+type GetB2BTaxInvoiceRequest struct {
+	DocumentKey string
+	// BRN is the business registration number of the company the document key belongs to.
+	BRN             string
+	DocumentKeyType B2BTaxInvoiceDocumentKeyType
+}
+
+// GetB2BTaxInvoice returns a tax invoice.
+func (bs *b2bService) GetB2BTaxInvoice(ctx context.Context, req GetB2BTaxInvoiceRequest) (B2BTaxInvoice, error) {
+	u := bs.baseURL.JoinPath("/tax-invoices", req.DocumentKey)
+
+	q := url.Values{}
+	q.Set("brn", req.BRN)
+	if req.DocumentKeyType != "" {
+		q.Set("documentKeyType", string(req.DocumentKeyType))
+	}
+	u.RawQuery = q.Encode()
+
+	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return B2BTaxInvoice{}, err
+	}
+
+	var resp B2BTaxInvoice
+	err = do(bs.httpClient, httpReq, &resp)
+	if err != nil {
+		return B2BTaxInvoice{}, err
+	}
+
+	return resp, nil
+}
+
+// B2BSearchDateType is the date a tax invoice search applies to.
+type B2BSearchDateType string
+
+const (
+	B2BSearchDateTypeRegister B2BSearchDateType = "REGISTER"
+	B2BSearchDateTypeWrite    B2BSearchDateType = "WRITE"
+	B2BSearchDateTypeIssue    B2BSearchDateType = "ISSUE"
+)
+
+// ListB2BTaxInvoicesRequest represents a request for 'GET /b2b/tax-invoices'.
+type ListB2BTaxInvoicesRequest struct {
+	// BRN is the business registration number of the company whose tax invoices are listed.
+	BRN string
+	// PageNumber starts at 0.
+	PageNumber int
+	PageSize   int
+	DateType   B2BSearchDateType
+	// FromDate and UntilDate are formatted as YYYYMMDD.
+	FromDate        string
+	UntilDate       string
+	DocumentKeyType B2BTaxInvoiceDocumentKeyType
+}
+
+// ListB2BTaxInvoicesResponse represents a response of 'GET /b2b/tax-invoices'.
+type ListB2BTaxInvoicesResponse struct {
+	Items []B2BTaxInvoice `json:"items"`
+	Page  PageInfo        `json:"page"`
+}
+
+// ListB2BTaxInvoices returns the tax invoices of a company, one page at a time.
+func (bs *b2bService) ListB2BTaxInvoices(ctx context.Context, req ListB2BTaxInvoicesRequest) (ListB2BTaxInvoicesResponse, error) {
+	u := bs.baseURL.JoinPath("/tax-invoices")
+
+	q := url.Values{}
+	q.Set("brn", req.BRN)
+	q.Set("pageNumber", strconv.Itoa(req.PageNumber))
+	if req.PageSize > 0 {
+		q.Set("pageSize", strconv.Itoa(req.PageSize))
+	}
+	if req.DateType != "" {
+		q.Set("dateType", string(req.DateType))
+	}
+	if req.FromDate != "" {
+		q.Set("fromDate", req.FromDate)
+	}
+	if req.UntilDate != "" {
+		q.Set("untilDate", req.UntilDate)
+	}
+	if req.DocumentKeyType != "" {
+		q.Set("documentKeyType", string(req.DocumentKeyType))
+	}
+	u.RawQuery = q.Encode()
+
+	httpReq, err := newRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return ListB2BTaxInvoicesResponse{}, err
+	}
+
+	var resp ListB2BTaxInvoicesResponse
+	err = do(bs.httpClient, httpReq, &resp)
+	if err != nil {
+		return ListB2BTaxInvoicesResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (bs *b2bService) doTaxInvoice(ctx context.Context, method string, u *url.URL, body any) (B2BTaxInvoiceResponse, error) {
+	httpReq, err := newRequest(ctx, method, u.String(), body)
+	if err != nil {
+		return B2BTaxInvoiceResponse{}, err
+	}
+
+	var resp B2BTaxInvoiceResponse
+	err = do(bs.httpClient, httpReq, &resp)
+	if err != nil {
+		return B2BTaxInvoiceResponse{}, err
+	}
+
+	return resp, nil
+}
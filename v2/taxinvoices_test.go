@@ -0,0 +1,146 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssueB2BTaxInvoice(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	supplier := v2.B2BCompany{
+		BRN:                "1234567890",
+		Name:               "test_supplier",
+		RepresentativeName: "홍길동",
+		Contact:            v2.B2BContact{Name: "홍길동", Email: "supplier@example.com"},
+	}
+	recipient := v2.B2BCompany{
+		BRN:                "0987654321",
+		Name:               "test_recipient",
+		RepresentativeName: "김철수",
+		Contact:            v2.B2BContact{Name: "김철수", Email: "recipient@example.com"},
+	}
+
+	mux.HandleFunc("/b2b/tax-invoices/register-issue", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.IssueB2BTaxInvoiceRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if diff := cmp.Diff(supplier, body.TaxInvoice.Supplier); diff != "" {
+			t.Errorf("unexpected supplier (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(recipient, body.TaxInvoice.Recipient); diff != "" {
+			t.Errorf("unexpected recipient (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"taxInvoice": {
+			"status": "ISSUED",
+			"writeDate": "20240101",
+			"purposeType": "RECEIPT",
+			"taxType": "TAXABLE",
+			"totalSupplyAmount": 10000,
+			"totalTaxAmount": 1000,
+			"totalAmount": 11000,
+			"supplierDocumentKey": "test_document_key"
+		}}`))
+	})
+
+	resp, err := client.IssueB2BTaxInvoice(context.Background(), v2.IssueB2BTaxInvoiceRequest{
+		TaxInvoice: v2.B2BTaxInvoiceInput{
+			WriteDate:         "20240101",
+			PurposeType:       v2.B2BTaxInvoicePurposeTypeReceipt,
+			TaxType:           v2.B2BTaxTypeTaxable,
+			TotalSupplyAmount: 10000,
+			TotalTaxAmount:    1000,
+			TotalAmount:       11000,
+			Supplier:          supplier,
+			Recipient:         recipient,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.TaxInvoice.Status != v2.B2BTaxInvoiceStatusIssued || resp.TaxInvoice.SupplierDocumentKey != "test_document_key" {
+		t.Errorf("unexpected tax invoice: %+v", resp.TaxInvoice)
+	}
+}
+
+func TestGetB2BTaxInvoice(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/b2b/tax-invoices/test_document_key", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		q := r.URL.Query()
+		if q.Get("brn") != "1234567890" || q.Get("documentKeyType") != "SUPPLIER" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status": "SENDING_COMPLETED", "ntsApprovalNumber": "test_approval_number"}`))
+	})
+
+	resp, err := client.GetB2BTaxInvoice(context.Background(), v2.GetB2BTaxInvoiceRequest{
+		DocumentKey:     "test_document_key",
+		BRN:             "1234567890",
+		DocumentKeyType: v2.B2BTaxInvoiceDocumentKeyTypeSupplier,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Status != v2.B2BTaxInvoiceStatusSendingCompleted || resp.NtsApprovalNumber != "test_approval_number" {
+		t.Errorf("unexpected tax invoice: %+v", resp)
+	}
+}
+
+func TestListB2BTaxInvoices(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/b2b/tax-invoices", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		want := "brn=1234567890&dateType=WRITE&fromDate=20240101&pageNumber=1&pageSize=20&untilDate=20240131"
+		if r.URL.RawQuery != want {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"items": [{"status": "ISSUED"}], "page": {"number": 1, "size": 20, "totalCount": 21}}`))
+	})
+
+	resp, err := client.ListB2BTaxInvoices(context.Background(), v2.ListB2BTaxInvoicesRequest{
+		BRN:        "1234567890",
+		PageNumber: 1,
+		PageSize:   20,
+		DateType:   v2.B2BSearchDateTypeWrite,
+		FromDate:   "20240101",
+		UntilDate:  "20240131",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Items) != 1 || resp.Page.HasNext() {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
@@ -0,0 +1,329 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// PlatformTransferType is the origin of a transfer.
+type PlatformTransferType string
+
+const (
+	PlatformTransferTypeOrder       PlatformTransferType = "ORDER"
+	PlatformTransferTypeOrderCancel PlatformTransferType = "ORDER_CANCEL"
+	PlatformTransferTypeManual      PlatformTransferType = "MANUAL"
+)
+
+// PlatformTransferStatus is the status of a transfer.
+type PlatformTransferStatus string
+
+const (
+	PlatformTransferStatusScheduled PlatformTransferStatus = "SCHEDULED"
+	PlatformTransferStatusInProcess PlatformTransferStatus = "IN_PROCESS"
+	PlatformTransferStatusSettled   PlatformTransferStatus = "SETTLED"
+	PlatformTransferStatusInPayout  PlatformTransferStatus = "IN_PAYOUT"
+	PlatformTransferStatusPaidOut   PlatformTransferStatus = "PAID_OUT"
+)
+
+// PlatformSettlementAmount is the breakdown of the amount settled to a partner.
+// Every amount is an integer in the minor unit of the settlement currency, and is negative for order cancel transfers.
+type PlatformSettlementAmount struct {
+	// Settlement is the amount transferred to the partner.
+	Settlement           int64 `json:"settlement"`
+	Payment              int64 `json:"payment"`
+	PaymentVat           int64 `json:"paymentVat"`
+	PaymentVatBurden     int64 `json:"paymentVatBurden"`
+	TaxFree              int64 `json:"taxFree"`
+	Supply               int64 `json:"supply"`
+	PaymentTaxFree       int64 `json:"paymentTaxFree"`
+	PaymentSupply        int64 `json:"paymentSupply"`
+	Order                int64 `json:"order"`
+	OrderTaxFree         int64 `json:"orderTaxFree"`
+	PlatformFee          int64 `json:"platformFee"`
+	PlatformFeeVat       int64 `json:"platformFeeVat"`
+	AdditionalFee        int64 `json:"additionalFee"`
+	AdditionalFeeVat     int64 `json:"additionalFeeVat"`
+	Discount             int64 `json:"discount"`
+	DiscountTaxFree      int64 `json:"discountTaxFree"`
+	DiscountShare        int64 `json:"discountShare"`
+	DiscountShareTaxFree int64 `json:"discountShareTaxFree"`
+}
+
+// PlatformTransferPayment is the payment an order or order cancel transfer settles.
+type PlatformTransferPayment struct {
+	ID        string     `json:"id"`
+	OrderName string     `json:"orderName"`
+	Currency  Currency   `json:"currency"`
+	PaidAt    *time.Time `json:"paidAt,omitempty"`
+}
+
+// PlatformTransfer represents an amount settled to a partner.
+// Contract, Payment and Amount are only set for order and order cancel transfers.
+type PlatformTransfer struct {
+	Type    PlatformTransferType   `json:"type"`
+	ID      string                 `json:"id"`
+	Partner PlatformPartner        `json:"partner"`
+	Status  PlatformTransferStatus `json:"status"`
+	Memo    string                 `json:"memo,omitempty"`
+	// SettlementDate is formatted as YYYY-MM-DD.
+	SettlementDate     string                    `json:"settlementDate"`
+	SettlementCurrency Currency                  `json:"settlementCurrency"`
+	IsForTest          bool                      `json:"isForTest"`
+	Contract           *PlatformContract         `json:"contract,omitempty"`
+	Payment            *PlatformTransferPayment  `json:"payment,omitempty"`
+	Amount             *PlatformSettlementAmount `json:"amount,omitempty"`
+	// SettlementAmount and SettlementTaxFreeAmount are only set for manual transfers.
+	SettlementAmount        int64 `json:"settlementAmount,omitempty"`
+	SettlementTaxFreeAmount int64 `json:"settlementTaxFreeAmount,omitempty"`
+}
+
+// PlatformOrderLineProduct is a product of an order line.
+type PlatformOrderLineProduct struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Amount int64  `json:"amount"`
+	Tag    string `json:"tag,omitempty"`
+}
+
+// PlatformDiscountInput is a discount applied to an order, shared between the platform and the partner
+// according to a discount share policy.
+type PlatformDiscountInput struct {
+	SharePolicyID string `json:"sharePolicyId"`
+	Amount        int64  `json:"amount"`
+	TaxFreeAmount int64  `json:"taxFreeAmount,omitempty"`
+}
+
+// PlatformAdditionalFeeInput is an additional fee charged to the partner according to a fee policy.
+type PlatformAdditionalFeeInput struct {
+	PolicyID string `json:"policyId"`
+}
+
+// PlatformOrderLineInput is a line of an order.
+type PlatformOrderLineInput struct {
+	Product        PlatformOrderLineProduct     `json:"product"`
+	Quantity       int64                        `json:"quantity"`
+	TaxFreeAmount  int64                        `json:"taxFreeAmount,omitempty"`
+	Discounts      []PlatformDiscountInput      `json:"discounts,omitempty"`
+	AdditionalFees []PlatformAdditionalFeeInput `json:"additionalFees,omitempty"`
+}
+
+// PlatformOrderDetailInput describes the order settled by a transfer. Exactly one field must be set.
+type PlatformOrderDetailInput struct {
+	OrderAmount *int64                   `json:"orderAmount,omitempty"`
+	OrderLines  []PlatformOrderLineInput `json:"orderLines,omitempty"`
+}
+
+// CreatePlatformOrderTransferRequest represents a request for 'POST /platform/transfers/order'.
+type CreatePlatformOrderTransferRequest struct {
+	PartnerID string `json:"partnerId"`
+	// ContractID defaults to the default contract of the partner.
+	ContractID    string                   `json:"contractId,omitempty"`
+	Memo          string                   `json:"memo,omitempty"`
+	PaymentID     string                   `json:"paymentId"`
+	OrderDetail   PlatformOrderDetailInput `json:"orderDetail"`
+	TaxFreeAmount int64                    `json:"taxFreeAmount,omitempty"`
+	// SettlementStartDate is formatted as YYYY-MM-DD and defaults to the date of the payment.
+	SettlementStartDate string                       `json:"settlementStartDate,omitempty"`
+	Discounts           []PlatformDiscountInput      `json:"discounts,omitempty"`
+	AdditionalFees      []PlatformAdditionalFeeInput `json:"additionalFees,omitempty"`
+	IsForTest           bool                         `json:"isForTest,omitempty"`
+}
+
+// PlatformTransferResponse represents a response of the endpoints creating a transfer.
+type PlatformTransferResponse struct {
+	Transfer PlatformTransfer `json:"transfer"`
+}
+
+// CreatePlatformOrderTransfer creates the transfer settling a payment to a partner.
+func (ps *platformService) CreatePlatformOrderTransfer(ctx context.Context, req CreatePlatformOrderTransferRequest) (PlatformTransferResponse, error) {
+	return ps.createTransfer(ctx, "/transfers/order", req)
+}
+
+// PlatformOrderCancelAllInput cancels the whole remaining order.
+type PlatformOrderCancelAllInput struct{}
+
+// PlatformOrderCancelDetailInput describes the cancelled part of an order. Exactly one field must be set.
+type PlatformOrderCancelDetailInput struct {
+	OrderAmount *int64                       `json:"orderAmount,omitempty"`
+	OrderLines  []PlatformOrderLineInput     `json:"orderLines,omitempty"`
+	All         *PlatformOrderCancelAllInput `json:"all,omitempty"`
+}
+
+// CreatePlatformOrderCancelTransferRequest represents a request for 'POST /platform/transfers/order-cancel'.
+// The order transfer is identified either by TransferID or by both PartnerID and PaymentID.
+type CreatePlatformOrderCancelTransferRequest struct {
+	PartnerID      string                         `json:"partnerId,omitempty"`
+	PaymentID      string                         `json:"paymentId,omitempty"`
+	TransferID     string                         `json:"transferId,omitempty"`
+	CancellationID string                         `json:"cancellationId"`
+	Memo           string                         `json:"memo,omitempty"`
+	OrderDetail    PlatformOrderCancelDetailInput `json:"orderDetail"`
+	TaxFreeAmount  int64                          `json:"taxFreeAmount,omitempty"`
+	Discounts      []PlatformDiscountInput        `json:"discounts,omitempty"`
+	// SettlementDate is formatted as YYYY-MM-DD and defaults to the settlement date of the order transfer.
+	SettlementDate string `json:"settlementDate,omitempty"`
+	IsForTest      bool   `json:"isForTest,omitempty"`
+}
+
+// CreatePlatformOrderCancelTransfer creates the transfer taking back the settlement of a cancelled payment.
+func (ps *platformService) CreatePlatformOrderCancelTransfer(ctx context.Context, req CreatePlatformOrderCancelTransferRequest) (PlatformTransferResponse, error) {
+	return ps.createTransfer(ctx, "/transfers/order-cancel", req)
+}
+
+// CreatePlatformManualTransferRequest represents a request for 'POST /platform/transfers/manual'.
+type CreatePlatformManualTransferRequest struct {
+	PartnerID               string `json:"partnerId"`
+	Memo                    string `json:"memo,omitempty"`
+	SettlementAmount        int64  `json:"settlementAmount"`
+	SettlementTaxFreeAmount int64  `json:"settlementTaxFreeAmount,omitempty"`
+	// SettlementDate is formatted as YYYY-MM-DD.
+	SettlementDate string `json:"settlementDate"`
+	IsForTest      bool   `json:"isForTest,omitempty"`
+}
+
+// CreatePlatformManualTransfer creates a transfer not tied to a payment, such as a bonus or a penalty.
+func (ps *platformService) CreatePlatformManualTransfer(ctx context.Context, req CreatePlatformManualTransferRequest) (PlatformTransferResponse, error) {
+	return ps.createTransfer(ctx, "/transfers/manual", req)
+}
+
+func (ps *platformService) createTransfer(ctx context.Context, path string, req any) (PlatformTransferResponse, error) {
+	var resp PlatformTransferResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath(path), req, &resp)
+	if err != nil {
+		return PlatformTransferResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetPlatformTransfer returns a transfer.
+func (ps *platformService) GetPlatformTransfer(ctx context.Context, transferID string) (PlatformTransfer, error) {
+	var resp PlatformTransfer
+	err := ps.send(ctx, http.MethodGet, ps.baseURL.JoinPath("/transfers", transferID), nil, &resp)
+	if err != nil {
+		return PlatformTransfer{}, err
+	}
+
+	return resp, nil
+}
+
+// PlatformTransferSummary is the summary of a transfer returned by the transfer list.
+type PlatformTransferSummary struct {
+	Type      PlatformTransferType   `json:"type"`
+	ID        string                 `json:"id"`
+	PartnerID string                 `json:"partnerId"`
+	Status    PlatformTransferStatus `json:"status"`
+	Memo      string                 `json:"memo,omitempty"`
+	// SettlementDate is formatted as YYYY-MM-DD.
+	SettlementDate          string   `json:"settlementDate"`
+	SettlementCurrency      Currency `json:"settlementCurrency"`
+	SettlementAmount        int64    `json:"settlementAmount"`
+	SettlementTaxFreeAmount int64    `json:"settlementTaxFreeAmount"`
+	// PaymentID is only set for order and order cancel transfers.
+	PaymentID string `json:"paymentId,omitempty"`
+	IsForTest bool   `json:"isForTest"`
+}
+
+// PlatformDateRange is an inclusive range of dates formatted as YYYY-MM-DD.
+type PlatformDateRange struct {
+	From  string `json:"from"`
+	Until string `json:"until"`
+}
+
+// PlatformTransferFilter restricts a transfer search. Zero fields are ignored.
+type PlatformTransferFilter struct {
+	SettlementDate *PlatformDateRange        `json:"settlementDate,omitempty"`
+	PartnerIDs     []string                  `json:"partnerIds,omitempty"`
+	ContractIDs    []string                  `json:"contractIds,omitempty"`
+	PaymentIDs     []string                  `json:"paymentIds,omitempty"`
+	Types          []PlatformTransferType    `json:"types,omitempty"`
+	Statuses       []PlatformTransferStatus  `json:"statuses,omitempty"`
+	PartnerTags    []string                  `json:"partnerTags,omitempty"`
+	PartnerTypes   []PlatformPartnerTypeKind `json:"partnerTypes,omitempty"`
+	// IsForTest selects test or live transfers. Live transfers are returned when nil.
+	IsForTest *bool `json:"isForTest,omitempty"`
+}
+
+// ListPlatformTransfersRequest represents a request for 'GET /platform/transfer-summaries'.
+type ListPlatformTransfersRequest struct {
+	Page   *PageInput              `json:"page,omitempty"`
+	Filter *PlatformTransferFilter `json:"filter,omitempty"`
+}
+
+// ListPlatformTransfersResponse represents a response of 'GET /platform/transfer-summaries'.
+type ListPlatformTransfersResponse struct {
+	Items []PlatformTransferSummary `json:"items"`
+	Page  PageInfo                  `json:"page"`
+}
+
+// ListPlatformTransfers returns the summaries of the transfers matching the filter, one page at a time.
+func (ps *platformService) ListPlatformTransfers(ctx context.Context, req ListPlatformTransfersRequest) (ListPlatformTransfersResponse, error) {
+	var resp ListPlatformTransfersResponse
+	err := ps.search(ctx, ps.baseURL.JoinPath("/transfer-summaries"), req, &resp)
+	if err != nil {
+		return ListPlatformTransfersResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// DeletePlatformTransfer deletes a transfer. Only scheduled transfers can be deleted.
+func (ps *platformService) DeletePlatformTransfer(ctx context.Context, transferID string) error {
+	return ps.send(ctx, http.MethodDelete, ps.baseURL.JoinPath("/transfers", transferID), nil, nil)
+}
+
+// CalculatePlatformSettlementAmountRequest represents a request for 'POST /platform/order-settlement-amount'.
+// It takes the same order description as CreatePlatformOrderTransferRequest without creating a transfer.
+type CalculatePlatformSettlementAmountRequest struct {
+	PartnerID string `json:"partnerId"`
+	// ContractID defaults to the default contract of the partner.
+	ContractID     string                       `json:"contractId,omitempty"`
+	Currency       Currency                     `json:"currency"`
+	OrderDetail    PlatformOrderDetailInput     `json:"orderDetail"`
+	TaxFreeAmount  int64                        `json:"taxFreeAmount,omitempty"`
+	Discounts      []PlatformDiscountInput      `json:"discounts,omitempty"`
+	AdditionalFees []PlatformAdditionalFeeInput `json:"additionalFees,omitempty"`
+}
+
+// CalculatePlatformSettlementAmountResponse represents a response of 'POST /platform/order-settlement-amount'.
+type CalculatePlatformSettlementAmountResponse struct {
+	Amount PlatformSettlementAmount `json:"amount"`
+}
+
+// CalculatePlatformSettlementAmount previews the amount an order transfer would settle to a partner.
+func (ps *platformService) CalculatePlatformSettlementAmount(ctx context.Context, req CalculatePlatformSettlementAmountRequest) (CalculatePlatformSettlementAmountResponse, error) {
+	var resp CalculatePlatformSettlementAmountResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath("/order-settlement-amount"), req, &resp)
+	if err != nil {
+		return CalculatePlatformSettlementAmountResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// CalculatePlatformDiscountShareRequest represents a request for 'POST /platform/discount-share-amount'.
+type CalculatePlatformDiscountShareRequest struct {
+	DiscountSharePolicyID string   `json:"discountSharePolicyId"`
+	Currency              Currency `json:"currency"`
+	DiscountAmount        int64    `json:"discountAmount"`
+	DiscountTaxFreeAmount int64    `json:"discountTaxFreeAmount,omitempty"`
+}
+
+// CalculatePlatformDiscountShareResponse represents a response of 'POST /platform/discount-share-amount'.
+// The two shares always add up to the discount amount.
+type CalculatePlatformDiscountShareResponse struct {
+	PartnerShareAmount  int64 `json:"partnerShareAmount"`
+	PlatformShareAmount int64 `json:"platformShareAmount"`
+}
+
+// CalculatePlatformDiscountShare previews how a discount is shared between the platform and a partner.
+func (ps *platformService) CalculatePlatformDiscountShare(ctx context.Context, req CalculatePlatformDiscountShareRequest) (CalculatePlatformDiscountShareResponse, error) {
+	var resp CalculatePlatformDiscountShareResponse
+	err := ps.send(ctx, http.MethodPost, ps.baseURL.JoinPath("/discount-share-amount"), req, &resp)
+	if err != nil {
+		return CalculatePlatformDiscountShareResponse{}, err
+	}
+
+	return resp, nil
+}
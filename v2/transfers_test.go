@@ -0,0 +1,217 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	v2 "github.com/connectfit-team/go-portone/v2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreatePlatformOrderTransfer(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/transfers/order", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"partnerId":   "test_partner_id",
+			"paymentId":   "test_payment_id",
+			"orderDetail": map[string]any{"orderAmount": float64(10000)},
+			"discounts":   []any{map[string]any{"sharePolicyId": "test_policy_id", "amount": float64(1000)}},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"transfer": {
+			"type": "ORDER",
+			"id": "test_transfer_id",
+			"partner": {"id": "test_partner_id"},
+			"status": "SCHEDULED",
+			"settlementDate": "2024-01-04",
+			"settlementCurrency": "KRW",
+			"isForTest": false,
+			"payment": {"id": "test_payment_id", "orderName": "test_order_name", "currency": "KRW"},
+			"amount": {"settlement": 8820, "payment": 9000, "order": 10000, "platformFee": 900, "platformFeeVat": 90, "discount": 1000, "discountShare": 500}
+		}}`))
+	})
+
+	orderAmount := int64(10000)
+	resp, err := client.CreatePlatformOrderTransfer(context.Background(), v2.CreatePlatformOrderTransferRequest{
+		PartnerID:   "test_partner_id",
+		PaymentID:   "test_payment_id",
+		OrderDetail: v2.PlatformOrderDetailInput{OrderAmount: &orderAmount},
+		Discounts:   []v2.PlatformDiscountInput{{SharePolicyID: "test_policy_id", Amount: 1000}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.PlatformSettlementAmount{
+		Settlement:     8820,
+		Payment:        9000,
+		Order:          10000,
+		PlatformFee:    900,
+		PlatformFeeVat: 90,
+		Discount:       1000,
+		DiscountShare:  500,
+	}
+	if diff := cmp.Diff(&want, resp.Transfer.Amount); diff != "" {
+		t.Errorf("unexpected amount (-want +got):\n%s", diff)
+	}
+	if resp.Transfer.Status != v2.PlatformTransferStatusScheduled || resp.Transfer.Payment.ID != "test_payment_id" {
+		t.Errorf("unexpected transfer: %+v", resp.Transfer)
+	}
+}
+
+func TestCreatePlatformOrderCancelTransfer(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/transfers/order-cancel", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		want := map[string]any{
+			"transferId":     "test_transfer_id",
+			"cancellationId": "test_cancellation_id",
+			"orderDetail":    map[string]any{"all": map[string]any{}},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"transfer": {"type": "ORDER_CANCEL", "id": "test_cancel_transfer_id", "amount": {"settlement": -8820}}}`))
+	})
+
+	resp, err := client.CreatePlatformOrderCancelTransfer(context.Background(), v2.CreatePlatformOrderCancelTransferRequest{
+		TransferID:     "test_transfer_id",
+		CancellationID: "test_cancellation_id",
+		OrderDetail:    v2.PlatformOrderCancelDetailInput{All: &v2.PlatformOrderCancelAllInput{}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Transfer.Type != v2.PlatformTransferTypeOrderCancel || resp.Transfer.Amount.Settlement != -8820 {
+		t.Errorf("unexpected transfer: %+v", resp.Transfer)
+	}
+}
+
+func TestListPlatformTransfers(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/transfer-summaries", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.ListPlatformTransfersRequest
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("requestBody")), &body); err != nil {
+			t.Errorf("unexpected request body: %v", err)
+		}
+
+		want := v2.ListPlatformTransfersRequest{
+			Filter: &v2.PlatformTransferFilter{
+				SettlementDate: &v2.PlatformDateRange{From: "2024-01-01", Until: "2024-01-31"},
+				PartnerIDs:     []string{"test_partner_id"},
+			},
+		}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("unexpected request body (-want +got):\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		// Amounts beyond the float64 precision must be kept exactly.
+		_, _ = w.Write([]byte(`{
+			"items": [{"type": "MANUAL", "id": "test_transfer_id", "partnerId": "test_partner_id", "settlementAmount": 9007199254740993}],
+			"page": {"number": 0, "size": 10, "totalCount": 1}
+		}`))
+	})
+
+	resp, err := client.ListPlatformTransfers(context.Background(), v2.ListPlatformTransfersRequest{
+		Filter: &v2.PlatformTransferFilter{
+			SettlementDate: &v2.PlatformDateRange{From: "2024-01-01", Until: "2024-01-31"},
+			PartnerIDs:     []string{"test_partner_id"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Items) != 1 || resp.Items[0].SettlementAmount != 9007199254740993 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestDeletePlatformTransfer(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/transfers/test_transfer_id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"type": "PLATFORM_TRANSFER_NON_DELETABLE_STATUS", "message": "transfer is already settled"}`))
+	})
+
+	err := client.DeletePlatformTransfer(context.Background(), "test_transfer_id")
+	if !v2.IsErrorType(err, v2.ErrorTypePlatformTransferNonDeletable) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCalculatePlatformDiscountShare(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/platform/discount-share-amount", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body v2.CalculatePlatformDiscountShareRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if body.DiscountSharePolicyID != "test_policy_id" || body.DiscountAmount != 1001 {
+			t.Errorf("unexpected body: %+v", body)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"partnerShareAmount": 500, "platformShareAmount": 501}`))
+	})
+
+	resp, err := client.CalculatePlatformDiscountShare(context.Background(), v2.CalculatePlatformDiscountShareRequest{
+		DiscountSharePolicyID: "test_policy_id",
+		Currency:              v2.CurrencyKRW,
+		DiscountAmount:        1001,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := v2.CalculatePlatformDiscountShareResponse{PartnerShareAmount: 500, PlatformShareAmount: 501}
+	if diff := cmp.Diff(want, resp); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
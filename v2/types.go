@@ -0,0 +1,76 @@
+package v2
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+const (
+	CurrencyKRW Currency = "KRW"
+	CurrencyUSD Currency = "USD"
+	CurrencyJPY Currency = "JPY"
+)
+
+// PageInput selects a page of a search. Number starts at 0.
+type PageInput struct {
+	Number int `json:"number,omitempty"`
+	Size   int `json:"size,omitempty"`
+}
+
+// PageInfo describes the page returned by a search.
+type PageInfo struct {
+	Number     int `json:"number"`
+	Size       int `json:"size"`
+	TotalCount int `json:"totalCount"`
+}
+
+// HasNext reports whether pages follow this one.
+func (p PageInfo) HasNext() bool {
+	return (p.Number+1)*p.Size < p.TotalCount
+}
+
+// ChannelType is the environment of a channel.
+type ChannelType string
+
+const (
+	ChannelTypeLive ChannelType = "LIVE"
+	ChannelTypeTest ChannelType = "TEST"
+)
+
+// SelectedChannel represents the channel a request was processed through.
+type SelectedChannel struct {
+	Type         ChannelType `json:"type"`
+	ID           string      `json:"id"`
+	Key          string      `json:"key"`
+	Name         string      `json:"name"`
+	PgProvider   string      `json:"pgProvider"`
+	PgMerchantID string      `json:"pgMerchantId"`
+}
+
+// CustomerName represents the name of a customer.
+type CustomerName struct {
+	Full      string `json:"full,omitempty"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+}
+
+// Customer represents the customer of a request.
+type Customer struct {
+	ID          string        `json:"id,omitempty"`
+	Name        *CustomerName `json:"name,omitempty"`
+	BirthYear   string        `json:"birthYear,omitempty"`
+	Gender      Gender        `json:"gender,omitempty"`
+	Email       string        `json:"email,omitempty"`
+	PhoneNumber string        `json:"phoneNumber,omitempty"`
+	Zipcode     string        `json:"zipcode,omitempty"`
+}
+
+// Gender is the gender of a person.
+type Gender string
+
+const (
+	GenderMale   Gender = "MALE"
+	GenderFemale Gender = "FEMALE"
+	GenderOther  Gender = "OTHER"
+)
+
+// Bank is a bank code of the V2 API (e.g. "KOOKMIN", "SHINHAN").
+type Bank string
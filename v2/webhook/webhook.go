@@ -0,0 +1,282 @@
+// Package webhook verifies and decodes the webhooks sent by PortOne V2.
+//
+// V2 webhooks follow the Standard Webhooks specification: each delivery is signed with
+// HMAC-SHA256 over its webhook-id, webhook-timestamp and body, and the signatures are sent
+// in the webhook-signature header.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	headerID        = "webhook-id"
+	headerTimestamp = "webhook-timestamp"
+	headerSignature = "webhook-signature"
+
+	secretPrefix     = "whsec_"
+	signatureVersion = "v1"
+)
+
+var defaultTolerance = 5 * time.Minute
+
+var (
+	// ErrMissingHeaders is returned when a delivery lacks one of the webhook headers.
+	ErrMissingHeaders = errors.New("webhook: missing webhook headers")
+	// ErrInvalidTimestamp is returned when the webhook-timestamp header is malformed or out of tolerance.
+	ErrInvalidTimestamp = errors.New("webhook: invalid timestamp")
+	// ErrInvalidSignature is returned when no signature matches any of the secrets.
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+)
+
+type verifierConfig struct {
+	tolerance time.Duration
+	now       func() time.Time
+	secrets   []string
+}
+
+// VerifierOption configures a Verifier.
+type VerifierOption func(*verifierConfig)
+
+// WithTolerance sets how far the webhook-timestamp may be from the current time. Defaults to 5 minutes.
+func WithTolerance(tolerance time.Duration) VerifierOption {
+	return func(c *verifierConfig) {
+		c.tolerance = tolerance
+	}
+}
+
+// WithAdditionalSecrets accepts the signatures made with other secrets as well,
+// for instance the previous secret while it is being rotated.
+func WithAdditionalSecrets(secrets ...string) VerifierOption {
+	return func(c *verifierConfig) {
+		c.secrets = append(c.secrets, secrets...)
+	}
+}
+
+// WithClock sets the function returning the current time. It is meant for tests.
+func WithClock(now func() time.Time) VerifierOption {
+	return func(c *verifierConfig) {
+		c.now = now
+	}
+}
+
+// Verifier verifies the signature of webhook deliveries.
+type Verifier struct {
+	tolerance time.Duration
+	now       func() time.Time
+	keys      [][]byte
+}
+
+// NewVerifier returns a Verifier for the webhook secret of the store, as shown in the console.
+// The "whsec_" prefix of the secret is optional.
+func NewVerifier(secret string, opts ...VerifierOption) (*Verifier, error) {
+	cfg := verifierConfig{
+		tolerance: defaultTolerance,
+		now:       time.Now,
+		secrets:   []string{secret},
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	keys := make([][]byte, 0, len(cfg.secrets))
+	for _, s := range cfg.secrets {
+		key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, secretPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("webhook: malformed secret: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	return &Verifier{
+		tolerance: cfg.tolerance,
+		now:       cfg.now,
+		keys:      keys,
+	}, nil
+}
+
+// Verify verifies the signature of a delivery and decodes its payload.
+func (v *Verifier) Verify(payload []byte, header http.Header) (Event, error) {
+	if err := v.VerifySignature(payload, header); err != nil {
+		return nil, err
+	}
+
+	return ParseEvent(payload)
+}
+
+// VerifySignature verifies the signature of a delivery without decoding it.
+func (v *Verifier) VerifySignature(payload []byte, header http.Header) error {
+	id := header.Get(headerID)
+	timestamp := header.Get(headerTimestamp)
+	signatures := header.Get(headerSignature)
+	if id == "" || timestamp == "" || signatures == "" {
+		return ErrMissingHeaders
+	}
+
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	now := v.now()
+	sentAt := time.Unix(sec, 0)
+	if sentAt.Before(now.Add(-v.tolerance)) || sentAt.After(now.Add(v.tolerance)) {
+		return ErrInvalidTimestamp
+	}
+
+	expected := make([][]byte, 0, len(v.keys))
+	for _, key := range v.keys {
+		expected = append(expected, sign(key, id, timestamp, payload))
+	}
+
+	for _, s := range strings.Fields(signatures) {
+		version, encoded, ok := strings.Cut(s, ",")
+		if !ok || version != signatureVersion {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+
+		for _, e := range expected {
+			if hmac.Equal(signature, e) {
+				return nil
+			}
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+func sign(key []byte, id, timestamp string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id))
+	mac.Write([]byte("."))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// Sign returns the webhook-signature header value of a delivery, as PortOne computes it.
+// It is meant to test webhook receivers.
+func Sign(secret, id string, timestamp time.Time, payload []byte) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, secretPrefix))
+	if err != nil {
+		return "", fmt.Errorf("webhook: malformed secret: %w", err)
+	}
+
+	signature := sign(key, id, strconv.FormatInt(timestamp.Unix(), 10), payload)
+	return signatureVersion + "," + base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// EventType is the type of a webhook event.
+type EventType string
+
+const (
+	EventTypeTransactionReady                EventType = "Transaction.Ready"
+	EventTypeTransactionPaid                 EventType = "Transaction.Paid"
+	EventTypeTransactionVirtualAccountIssued EventType = "Transaction.VirtualAccountIssued"
+	EventTypeTransactionPartialCancelled     EventType = "Transaction.PartialCancelled"
+	EventTypeTransactionCancelled            EventType = "Transaction.Cancelled"
+	EventTypeTransactionFailed               EventType = "Transaction.Failed"
+	EventTypeTransactionPayPending           EventType = "Transaction.PayPending"
+	EventTypeTransactionCancelPending        EventType = "Transaction.CancelPending"
+	EventTypeBillingKeyReady                 EventType = "BillingKey.Ready"
+	EventTypeBillingKeyIssued                EventType = "BillingKey.Issued"
+	EventTypeBillingKeyFailed                EventType = "BillingKey.Failed"
+	EventTypeBillingKeyDeleted               EventType = "BillingKey.Deleted"
+	EventTypeBillingKeyUpdated               EventType = "BillingKey.Updated"
+)
+
+// Event is a webhook event. Use a type switch to access the data of an event:
+//
+//	switch e := event.(type) {
+//	case *webhook.TransactionEvent:
+//		// fetch e.Data.PaymentID
+//	case *webhook.BillingKeyEvent:
+//		// fetch e.Data.BillingKey
+//	}
+type Event interface {
+	EventType() EventType
+	EventTimestamp() time.Time
+}
+
+// EventHeader holds the fields shared by every event.
+type EventHeader struct {
+	Type      EventType `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// EventType implements Event.
+func (h EventHeader) EventType() EventType {
+	return h.Type
+}
+
+// EventTimestamp implements Event.
+func (h EventHeader) EventTimestamp() time.Time {
+	return h.Timestamp
+}
+
+// TransactionEvent is sent when the status of a payment changes.
+// The payment should be fetched from the API to get its up-to-date state.
+type TransactionEvent struct {
+	EventHeader
+	Data struct {
+		PaymentID      string `json:"paymentId"`
+		StoreID        string `json:"storeId"`
+		TransactionID  string `json:"transactionId"`
+		CancellationID string `json:"cancellationId,omitempty"`
+	} `json:"data"`
+}
+
+// BillingKeyEvent is sent when the status of a billing key changes.
+type BillingKeyEvent struct {
+	EventHeader
+	Data struct {
+		BillingKey string `json:"billingKey"`
+		StoreID    string `json:"storeId"`
+	} `json:"data"`
+}
+
+// UnrecognizedEvent is an event of a type unknown to this package. Raw holds its JSON representation.
+type UnrecognizedEvent struct {
+	EventHeader
+	Raw json.RawMessage `json:"-"`
+}
+
+// ParseEvent decodes a webhook payload into the type matching its event type.
+// It does not verify the payload, use Verifier.Verify for deliveries received over the network.
+func ParseEvent(payload []byte) (Event, error) {
+	var header EventHeader
+	if err := json.Unmarshal(payload, &header); err != nil {
+		return nil, err
+	}
+
+	var e Event
+	switch {
+	case strings.HasPrefix(string(header.Type), "Transaction."):
+		e = &TransactionEvent{}
+	case strings.HasPrefix(string(header.Type), "BillingKey."):
+		e = &BillingKeyEvent{}
+	default:
+		e = &UnrecognizedEvent{Raw: append(json.RawMessage(nil), payload...)}
+	}
+
+	if err := json.Unmarshal(payload, e); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
@@ -0,0 +1,195 @@
+package webhook_test
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/connectfit-team/go-portone/v2/webhook"
+)
+
+// Test vector of the Standard Webhooks specification.
+const (
+	testSecret    = "whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw"
+	testID        = "msg_p5jXN8AQM9LWM0D4loKWxJek"
+	testTimestamp = "1614265330"
+	testPayload   = `{"test": 2432232314}`
+	testSignature = "v1,g0hM9SsE+OTPJTGt/tmIKtSyZlE3uFJELVlNIOLJ1OE="
+)
+
+var testNow = time.Unix(1614265330, 0)
+
+func testHeader(signature string) http.Header {
+	h := http.Header{}
+	h.Set("webhook-id", testID)
+	h.Set("webhook-timestamp", testTimestamp)
+	h.Set("webhook-signature", signature)
+	return h
+}
+
+func TestVerifySignature(t *testing.T) {
+	const otherSecret = "whsec_dGVzdF9vdGhlcl9zZWNyZXQ="
+
+	tests := []struct {
+		name    string
+		secret  string
+		opts    []webhook.VerifierOption
+		header  http.Header
+		now     time.Time
+		wantErr error
+	}{
+		{
+			name:   "valid",
+			secret: testSecret,
+			header: testHeader(testSignature),
+			now:    testNow,
+		},
+		{
+			name:   "valid among several signatures",
+			secret: testSecret,
+			header: testHeader("v1,Zm9v " + testSignature),
+			now:    testNow,
+		},
+		{
+			name:   "previous secret during rotation",
+			secret: otherSecret,
+			opts:   []webhook.VerifierOption{webhook.WithAdditionalSecrets(testSecret)},
+			header: testHeader(testSignature),
+			now:    testNow,
+		},
+		{
+			name:    "wrong secret",
+			secret:  otherSecret,
+			header:  testHeader(testSignature),
+			now:     testNow,
+			wantErr: webhook.ErrInvalidSignature,
+		},
+		{
+			name:    "unsupported version",
+			secret:  testSecret,
+			header:  testHeader("v2,g0hM9SsE+OTPJTGt/tmIKtSyZlE3uFJELVlNIOLJ1OE="),
+			now:     testNow,
+			wantErr: webhook.ErrInvalidSignature,
+		},
+		{
+			name:    "too old",
+			secret:  testSecret,
+			header:  testHeader(testSignature),
+			now:     testNow.Add(6 * time.Minute),
+			wantErr: webhook.ErrInvalidTimestamp,
+		},
+		{
+			name:    "too new",
+			secret:  testSecret,
+			header:  testHeader(testSignature),
+			now:     testNow.Add(-6 * time.Minute),
+			wantErr: webhook.ErrInvalidTimestamp,
+		},
+		{
+			name:   "custom tolerance",
+			secret: testSecret,
+			opts:   []webhook.VerifierOption{webhook.WithTolerance(time.Hour)},
+			header: testHeader(testSignature),
+			now:    testNow.Add(30 * time.Minute),
+		},
+		{
+			name:    "missing headers",
+			secret:  testSecret,
+			header:  http.Header{},
+			now:     testNow,
+			wantErr: webhook.ErrMissingHeaders,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := tt.now
+			opts := append([]webhook.VerifierOption{webhook.WithClock(func() time.Time { return now })}, tt.opts...)
+
+			v, err := webhook.NewVerifier(tt.secret, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = v.VerifySignature([]byte(testPayload), tt.header)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("unexpected error: got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	got, err := webhook.Sign(testSecret, testID, testNow, []byte(testPayload))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != testSignature {
+		t.Errorf("unexpected signature: %s", got)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	payload := []byte(`{
+		"type": "Transaction.Paid",
+		"timestamp": "2024-04-25T10:00:00.000Z",
+		"data": {
+			"paymentId": "test_payment_id",
+			"storeId": "store-test",
+			"transactionId": "test_transaction_id"
+		}
+	}`)
+
+	now := time.Now()
+	signature, err := webhook.Sign(testSecret, testID, now, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := http.Header{}
+	header.Set("webhook-id", testID)
+	header.Set("webhook-timestamp", strconv.FormatInt(now.Unix(), 10))
+	header.Set("webhook-signature", signature)
+
+	v, err := webhook.NewVerifier(testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := v.Verify(payload, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, ok := event.(*webhook.TransactionEvent)
+	if !ok {
+		t.Fatalf("unexpected event type: %T", event)
+	}
+
+	if e.EventType() != webhook.EventTypeTransactionPaid || e.Data.PaymentID != "test_payment_id" || e.Data.TransactionID != "test_transaction_id" {
+		t.Errorf("unexpected event: %+v", e)
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	event, err := webhook.ParseEvent([]byte(`{"type": "BillingKey.Issued", "timestamp": "2024-04-25T10:00:00Z", "data": {"billingKey": "test_billing_key", "storeId": "store-test"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e, ok := event.(*webhook.BillingKeyEvent); !ok || e.Data.BillingKey != "test_billing_key" {
+		t.Errorf("unexpected event: %#v", event)
+	}
+
+	event, err = webhook.ParseEvent([]byte(`{"type": "Unknown.Event", "timestamp": "2024-04-25T10:00:00Z"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e, ok := event.(*webhook.UnrecognizedEvent); !ok || len(e.Raw) == 0 {
+		t.Errorf("unexpected event: %#v", event)
+	}
+}
@@ -0,0 +1,160 @@
+package portone
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+type vbanksService struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	tier       string
+}
+
+func newVbanksService(baseURL *url.URL, httpClient *http.Client, tier string) *vbanksService {
+	return &vbanksService{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		tier:       tier,
+	}
+}
+
+// Vbank represents the virtual account information of a payment.
+type Vbank struct {
+	VbankCode     string `json:"vbank_code"`
+	VbankName     string `json:"vbank_name"`
+	VbankNum      string `json:"vbank_num"`
+	VbankHolder   string `json:"vbank_holder"`
+	VbankDate     int    `json:"vbank_date"`
+	VbankIssuedAt int    `json:"vbank_issued_at"`
+}
+
+// IssueVbankRequest represents a request for 'POST /vbanks'.
+type IssueVbankRequest struct {
+	MerchantUID   string   `json:"merchant_uid"`
+	Amount        int64    `json:"amount"`
+	VbankCode     string   `json:"vbank_code"`
+	VbankDue      int64    `json:"vbank_due"`
+	VbankHolder   string   `json:"vbank_holder"`
+	Name          string   `json:"name,omitempty"`
+	BuyerName     string   `json:"buyer_name,omitempty"`
+	BuyerEmail    string   `json:"buyer_email,omitempty"`
+	BuyerTel      string   `json:"buyer_tel,omitempty"`
+	BuyerAddr     string   `json:"buyer_addr,omitempty"`
+	BuyerPostcode string   `json:"buyer_postcode,omitempty"`
+	Pg            string   `json:"pg,omitempty"`
+	NoticeURL     []string `json:"notice_url,omitempty"`
+	CustomData    string   `json:"custom_data,omitempty"`
+}
+
+// IssueVbankResponse represents a response of 'POST /vbanks'.
+type IssueVbankResponse struct {
+	CommonResponse
+	Response Payment `json:"response"`
+}
+
+// IssueVbank issues a new virtual account.
+func (vs *vbanksService) IssueVbank(ctx context.Context, req IssueVbankRequest) (IssueVbankResponse, error) {
+	httpReq, err := newRequest(ctx, vs.tier, http.MethodPost, vs.baseURL.String(), req)
+	if err != nil {
+		return IssueVbankResponse{}, err
+	}
+
+	var resp IssueVbankResponse
+	err = do(vs.httpClient, httpReq, &resp)
+	if err != nil {
+		return IssueVbankResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// UpdateVbankRequest represents a request for 'PUT /vbanks/{imp_uid}'.
+type UpdateVbankRequest struct {
+	ImpUID   string `json:"-"`
+	Amount   int64  `json:"amount,omitempty"`
+	VbankDue int64  `json:"vbank_due,omitempty"`
+}
+
+// UpdateVbankResponse represents a response of 'PUT /vbanks/{imp_uid}'.
+type UpdateVbankResponse struct {
+	CommonResponse
+	Response Payment `json:"response"`
+}
+
+// UpdateVbank changes the amount or the due date of an issued virtual account.
+func (vs *vbanksService) UpdateVbank(ctx context.Context, req UpdateVbankRequest) (UpdateVbankResponse, error) {
+	u := vs.baseURL.JoinPath(req.ImpUID)
+	httpReq, err := newRequest(ctx, vs.tier, http.MethodPut, u.String(), req)
+	if err != nil {
+		return UpdateVbankResponse{}, err
+	}
+
+	var resp UpdateVbankResponse
+	err = do(vs.httpClient, httpReq, &resp)
+	if err != nil {
+		return UpdateVbankResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// DeleteVbankResponse represents a response of 'DELETE /vbanks/{imp_uid}'.
+type DeleteVbankResponse struct {
+	CommonResponse
+	Response Payment `json:"response"`
+}
+
+// DeleteVbank cancels an issued virtual account before it is paid.
+func (vs *vbanksService) DeleteVbank(ctx context.Context, impUID string) (DeleteVbankResponse, error) {
+	u := vs.baseURL.JoinPath(impUID)
+	httpReq, err := newRequest(ctx, vs.tier, http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return DeleteVbankResponse{}, err
+	}
+
+	var resp DeleteVbankResponse
+	err = do(vs.httpClient, httpReq, &resp)
+	if err != nil {
+		return DeleteVbankResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetVbankHolderRequest represents a request for 'GET /vbanks/holder'.
+type GetVbankHolderRequest struct {
+	BankCode string
+	BankNum  string
+}
+
+// GetVbankHolderResponse represents a response of 'GET /vbanks/holder'.
+type GetVbankHolderResponse struct {
+	CommonResponse
+	Response struct {
+		BankHolder string `json:"bank_holder"`
+	} `json:"response"`
+}
+
+// GetVbankHolder returns the holder name of a bank account.
+func (vs *vbanksService) GetVbankHolder(ctx context.Context, req GetVbankHolderRequest) (GetVbankHolderResponse, error) {
+	u := vs.baseURL.JoinPath("/holder")
+	q := u.Query()
+	q.Set("bank_code", req.BankCode)
+	q.Set("bank_num", req.BankNum)
+	u.RawQuery = q.Encode()
+
+	httpReq, err := newRequest(ctx, vs.tier, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return GetVbankHolderResponse{}, err
+	}
+
+	var resp GetVbankHolderResponse
+	err = do(vs.httpClient, httpReq, &resp)
+	if err != nil {
+		return GetVbankHolderResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,203 @@
+package portone_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssueVbank(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/vbanks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		if r.Header.Get("Content-Type") != contentTypeJSON {
+			t.Errorf("unexpected content type: %s", r.Header.Get("Content-Type"))
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if body["vbank_code"] != "004" || body["vbank_holder"] != "test_vbank_holder" {
+			t.Errorf("unexpected body: %v", body)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"imp_uid": "test_imp_uid",
+				"merchant_uid": "test_merchant_uid",
+				"pay_method": "vbank",
+				"amount": 1000,
+				"status": "ready",
+				"vbank_code": "004",
+				"vbank_name": "KB국민은행",
+				"vbank_num": "test_vbank_num",
+				"vbank_holder": "test_vbank_holder",
+				"vbank_date": 1700000000,
+				"vbank_issued_at": 1600000000
+			}
+		}`))
+	})
+
+	resp, err := client.IssueVbank(context.Background(), portone.IssueVbankRequest{
+		MerchantUID: "test_merchant_uid",
+		Amount:      1000,
+		VbankCode:   "004",
+		VbankDue:    1700000000,
+		VbankHolder: "test_vbank_holder",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := portone.IssueVbankResponse{
+		CommonResponse: portone.CommonResponse{
+			Code:    0,
+			Message: "success",
+		},
+		Response: portone.Payment{
+			ImpUID:      "test_imp_uid",
+			MerchantUID: "test_merchant_uid",
+			PayMethod:   "vbank",
+			Amount:      1000,
+			Status:      "ready",
+			Vbank: portone.Vbank{
+				VbankCode:     "004",
+				VbankName:     "KB국민은행",
+				VbankNum:      "test_vbank_num",
+				VbankHolder:   "test_vbank_holder",
+				VbankDate:     1700_000_000,
+				VbankIssuedAt: 1600_000_000,
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, resp); diff != "" {
+		t.Errorf("unexpected response (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpdateVbank(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/vbanks/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+
+		if _, ok := body["amount"]; ok {
+			t.Errorf("unexpected amount in body: %v", body)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"imp_uid": "test_imp_uid",
+				"vbank_date": 1800000000
+			}
+		}`))
+	})
+
+	resp, err := client.UpdateVbank(context.Background(), portone.UpdateVbankRequest{
+		ImpUID:   "test_imp_uid",
+		VbankDue: 1800000000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Response.VbankDate != 1800_000_000 {
+		t.Errorf("unexpected vbank date: %d", resp.Response.VbankDate)
+	}
+}
+
+func TestDeleteVbank(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/vbanks/test_imp_uid", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"imp_uid": "test_imp_uid",
+				"status": "cancelled"
+			}
+		}`))
+	})
+
+	resp, err := client.DeleteVbank(context.Background(), "test_imp_uid")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Response.Status != "cancelled" {
+		t.Errorf("unexpected status: %s", resp.Response.Status)
+	}
+}
+
+func TestGetVbankHolder(t *testing.T) {
+	client, mux := mustInitClientWithAuthentication(t)
+
+	mux.HandleFunc("/vbanks/holder", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		if got := r.URL.Query().Get("bank_code"); got != "004" {
+			t.Errorf("unexpected bank_code: %s", got)
+		}
+
+		if got := r.URL.Query().Get("bank_num"); got != "1234567890" {
+			t.Errorf("unexpected bank_num: %s", got)
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"code": 0,
+			"message": "success",
+			"response": {
+				"bank_holder": "홍길동"
+			}
+		}`))
+	})
+
+	resp, err := client.GetVbankHolder(context.Background(), portone.GetVbankHolderRequest{
+		BankCode: "004",
+		BankNum:  "1234567890",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Response.BankHolder != "홍길동" {
+		t.Errorf("unexpected bank holder: %s", resp.Response.BankHolder)
+	}
+}
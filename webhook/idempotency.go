@@ -0,0 +1,200 @@
+package webhook
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+// Store records the payment transitions which have already been processed.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Has reports whether key has already been processed.
+	Has(ctx context.Context, key string) (bool, error)
+	// Put records key as processed.
+	Put(ctx context.Context, key string) error
+}
+
+// IdempotencyKey returns the key identifying a payment transition, made of its imp_uid, status and cancelled amount.
+// The cancelled amount tells apart the partial cancellations, after which a payment stays paid.
+func IdempotencyKey(payment portone.Payment) string {
+	return payment.ImpUID + ":" + payment.Status + ":" + strconv.Itoa(payment.CancelAmount)
+}
+
+// Idempotent wraps fn so it is invoked only once per payment transition, even if PortOne
+// delivers the same notification several times. A transition is recorded in store only
+// once fn succeeded, so a failed delivery is processed again on retry.
+func Idempotent(store Store, fn HandlerFunc) HandlerFunc {
+	var locks keyedMutex
+
+	return func(ctx context.Context, payment portone.Payment) error {
+		key := IdempotencyKey(payment)
+
+		unlock := locks.lock(key)
+		defer unlock()
+
+		done, err := store.Has(ctx, key)
+		if err != nil {
+			return fmt.Errorf("webhook: check idempotency key %s: %w", key, err)
+		}
+		if done {
+			return nil
+		}
+
+		if err := fn(ctx, payment); err != nil {
+			return err
+		}
+
+		if err := store.Put(ctx, key); err != nil {
+			return fmt.Errorf("webhook: record idempotency key %s: %w", key, err)
+		}
+
+		return nil
+	}
+}
+
+// keyedMutex serializes the processing of duplicated deliveries arriving concurrently.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedMutexEntry
+}
+
+type keyedMutexEntry struct {
+	mu   sync.Mutex
+	refs int
+}
+
+func (km *keyedMutex) lock(key string) (unlock func()) {
+	km.mu.Lock()
+	if km.locks == nil {
+		km.locks = make(map[string]*keyedMutexEntry)
+	}
+	e, ok := km.locks[key]
+	if !ok {
+		e = &keyedMutexEntry{}
+		km.locks[key] = e
+	}
+	e.refs++
+	km.mu.Unlock()
+
+	e.mu.Lock()
+
+	return func() {
+		e.mu.Unlock()
+
+		km.mu.Lock()
+		e.refs--
+		if e.refs == 0 {
+			delete(km.locks, key)
+		}
+		km.mu.Unlock()
+	}
+}
+
+// MemoryStore is a Store keeping the processed keys in memory.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	keys map[string]struct{}
+}
+
+// NewMemoryStore returns a new empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		keys: make(map[string]struct{}),
+	}
+}
+
+// Has implements Store.
+func (s *MemoryStore) Has(_ context.Context, key string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.keys[key]
+	return ok, nil
+}
+
+// Put implements Store.
+func (s *MemoryStore) Put(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key] = struct{}{}
+	return nil
+}
+
+// FileStore is a Store persisting the processed keys in a file, one per line,
+// so deliveries processed before a restart are still recognized.
+type FileStore struct {
+	mu   sync.Mutex
+	f    *os.File
+	keys map[string]struct{}
+}
+
+// NewFileStore opens or creates the file at path and loads the keys it contains.
+func NewFileStore(path string) (*FileStore, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]struct{})
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if key := strings.TrimSpace(sc.Text()); key != "" {
+			keys[key] = struct{}{}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &FileStore{
+		f:    f,
+		keys: keys,
+	}, nil
+}
+
+// Has implements Store.
+func (s *FileStore) Has(_ context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.keys[key]
+	return ok, nil
+}
+
+// Put implements Store.
+func (s *FileStore) Put(_ context.Context, key string) error {
+	if strings.ContainsAny(key, "\r\n") {
+		return fmt.Errorf("webhook: invalid idempotency key %q", key)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.keys[key]; ok {
+		return nil
+	}
+
+	if _, err := s.f.WriteString(key + "\n"); err != nil {
+		return err
+	}
+	if err := s.f.Sync(); err != nil {
+		return err
+	}
+
+	s.keys[key] = struct{}{}
+	return nil
+}
+
+// Close closes the underlying file.
+func (s *FileStore) Close() error {
+	return s.f.Close()
+}
@@ -0,0 +1,135 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/connectfit-team/go-portone/webhook"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIdempotent(t *testing.T) {
+	var calls atomic.Int32
+	fail := true
+	fn := webhook.Idempotent(webhook.NewMemoryStore(), func(ctx context.Context, payment portone.Payment) error {
+		calls.Add(1)
+		if fail {
+			return errors.New("test error")
+		}
+		return nil
+	})
+
+	paid := portone.Payment{ImpUID: "test_imp_uid", Status: "paid"}
+
+	if err := fn(context.Background(), paid); err == nil {
+		t.Fatal("expected the failure to be reported")
+	}
+
+	fail = false
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(context.Background(), paid); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("unexpected number of calls after duplicated deliveries: %d", got)
+	}
+
+	cancelled := portone.Payment{ImpUID: "test_imp_uid", Status: "cancelled"}
+	if err := fn(context.Background(), cancelled); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := calls.Load(); got != 3 {
+		t.Errorf("expected a new transition to be processed, got %d calls", got)
+	}
+}
+
+func TestIdempotentPartialCancellations(t *testing.T) {
+	payments := fakePayments{
+		"test_imp_uid": {ImpUID: "test_imp_uid", Status: "paid", Amount: 1000},
+	}
+
+	var cancelAmounts []int
+	h := webhook.NewHandler(payments, webhook.Idempotent(webhook.NewMemoryStore(), func(ctx context.Context, payment portone.Payment) error {
+		cancelAmounts = append(cancelAmounts, payment.CancelAmount)
+		return nil
+	}))
+
+	notify := func() {
+		t.Helper()
+
+		r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{"imp_uid": "test_imp_uid", "status": "paid"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("unexpected status code: %d", w.Code)
+		}
+	}
+
+	notify()
+
+	// The payment stays paid after each partial cancellation.
+	for _, cancelAmount := range []int{300, 500} {
+		p := payments["test_imp_uid"]
+		p.CancelAmount = cancelAmount
+		payments["test_imp_uid"] = p
+
+		notify()
+		notify()
+	}
+
+	if diff := cmp.Diff([]int{0, 300, 500}, cancelAmounts); diff != "" {
+		t.Errorf("unexpected processed cancel amounts (-want +got):\n%s", diff)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhook.keys")
+	ctx := context.Background()
+
+	store, err := webhook.NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Put(ctx, "test_imp_uid:paid"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err = webhook.NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	if ok, err := store.Has(ctx, "test_imp_uid:paid"); err != nil || !ok {
+		t.Errorf("expected key to be persisted: %v, %v", ok, err)
+	}
+
+	if ok, err := store.Has(ctx, "test_imp_uid:cancelled"); err != nil || ok {
+		t.Errorf("unexpected key: %v, %v", ok, err)
+	}
+}
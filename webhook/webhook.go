@@ -0,0 +1,230 @@
+// Package webhook provides an http.Handler receiving PortOne payment notifications.
+//
+// A notification only carries identifiers and a status which can be forged by anyone,
+// so the handler always fetches the payment from PortOne before handing it to the callback.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/connectfit-team/go-portone"
+)
+
+// DefaultAllowedIPs are the addresses PortOne sends notifications from.
+var DefaultAllowedIPs = []string{
+	"52.78.100.19",
+	"52.78.48.223",
+	"52.78.5.241",
+}
+
+// Notification represents the payload PortOne posts to the notification URL.
+type Notification struct {
+	ImpUID         string `json:"imp_uid"`
+	MerchantUID    string `json:"merchant_uid"`
+	Status         string `json:"status"`
+	CancellationID string `json:"cancellation_id,omitempty"`
+}
+
+// PaymentGetter fetches a payment from PortOne. It is satisfied by *portone.Client.
+type PaymentGetter interface {
+	GetPayment(ctx context.Context, paymentID string) (portone.GetPaymentResponse, error)
+}
+
+// HandlerFunc processes a payment whose notification has been verified against PortOne.
+// Returning an error makes the handler answer with a failure status so PortOne retries the notification.
+type HandlerFunc func(ctx context.Context, payment portone.Payment) error
+
+var (
+	// ErrForbiddenIP is reported when a notification comes from an address not in the allow list.
+	ErrForbiddenIP = errors.New("webhook: notification from a forbidden address")
+	// ErrInvalidNotification is reported when a notification cannot be parsed or misses its imp_uid.
+	ErrInvalidNotification = errors.New("webhook: invalid notification")
+	// ErrMismatchedPayment is reported when the fetched payment does not match the notification.
+	ErrMismatchedPayment = errors.New("webhook: payment does not match the notification")
+)
+
+type handlerConfig struct {
+	allowedIPs     map[string]struct{}
+	trustForwarded bool
+	errorHandler   func(r *http.Request, err error)
+}
+
+// HandlerOption configures a Handler.
+type HandlerOption func(*handlerConfig)
+
+// WithAllowedIPs rejects the notifications not sent from one of the given addresses.
+// Use DefaultAllowedIPs to only accept notifications from PortOne.
+func WithAllowedIPs(ips ...string) HandlerOption {
+	return func(c *handlerConfig) {
+		c.allowedIPs = make(map[string]struct{}, len(ips))
+		for _, ip := range ips {
+			c.allowedIPs[ip] = struct{}{}
+		}
+	}
+}
+
+// WithForwardedFor makes the allow list check the last address of the X-Forwarded-For header
+// instead of the remote address, that is the one the proxy in front of the handler saw.
+// The previous addresses are set by the client and cannot be trusted.
+// Only use it behind a single proxy which appends to the header.
+func WithForwardedFor() HandlerOption {
+	return func(c *handlerConfig) {
+		c.trustForwarded = true
+	}
+}
+
+// WithErrorHandler sets a function called with every error making the handler reject a notification.
+func WithErrorHandler(fn func(r *http.Request, err error)) HandlerOption {
+	return func(c *handlerConfig) {
+		c.errorHandler = fn
+	}
+}
+
+// Handler is an http.Handler receiving PortOne notifications.
+type Handler struct {
+	handlerConfig
+
+	payments PaymentGetter
+	fn       HandlerFunc
+}
+
+// NewHandler returns a new Handler calling fn with the payments fetched by payments.
+func NewHandler(payments PaymentGetter, fn HandlerFunc, opts ...HandlerOption) *Handler {
+	var cfg handlerConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return &Handler{
+		handlerConfig: cfg,
+		payments:      payments,
+		fn:            fn,
+	}
+}
+
+// maxNotificationSize is the maximum size of a notification body. Notifications only carry a few identifiers.
+const maxNotificationSize = 4 << 10
+
+// ServeHTTP answers with:
+//   - 200 when the callback succeeded,
+//   - 400 when the notification is malformed or does not match the payment,
+//   - 403 when the notification comes from a forbidden address,
+//   - 405 when the method is not POST,
+//   - 413 when the notification is larger than 4 KiB,
+//   - 502 when the payment cannot be fetched from PortOne,
+//   - 500 when the callback failed.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.fail(w, r, http.StatusMethodNotAllowed, fmt.Errorf("webhook: unexpected method %s", r.Method))
+		return
+	}
+
+	if !h.isAllowed(r) {
+		h.fail(w, r, http.StatusForbidden, ErrForbiddenIP)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxNotificationSize)
+	n, err := ParseNotification(r)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		h.fail(w, r, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := h.payments.GetPayment(r.Context(), n.ImpUID)
+	if err == nil {
+		err = resp.Err()
+	}
+	if err != nil {
+		h.fail(w, r, http.StatusBadGateway, fmt.Errorf("webhook: fetch payment %s: %w", n.ImpUID, err))
+		return
+	}
+
+	payment := resp.Response
+	if payment.ImpUID != n.ImpUID || (n.MerchantUID != "" && payment.MerchantUID != n.MerchantUID) {
+		h.fail(w, r, http.StatusBadRequest, ErrMismatchedPayment)
+		return
+	}
+
+	if err := h.fn(r.Context(), payment); err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, code int, err error) {
+	if h.errorHandler != nil {
+		h.errorHandler(r, err)
+	}
+
+	http.Error(w, http.StatusText(code), code)
+}
+
+func (h *Handler) isAllowed(r *http.Request) bool {
+	if h.allowedIPs == nil {
+		return true
+	}
+
+	_, ok := h.allowedIPs[h.clientIP(r)]
+	return ok
+}
+
+func (h *Handler) clientIP(r *http.Request) string {
+	if h.trustForwarded {
+		if values := r.Header.Values("X-Forwarded-For"); len(values) > 0 {
+			xff := values[len(values)-1]
+			return strings.TrimSpace(xff[strings.LastIndex(xff, ",")+1:])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// ParseNotification parses a notification sent either as JSON or as a form.
+func ParseNotification(r *http.Request) (Notification, error) {
+	var n Notification
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/json" {
+		if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+			return Notification{}, fmt.Errorf("%w: %w", ErrInvalidNotification, err)
+		}
+	} else {
+		if err := r.ParseForm(); err != nil {
+			return Notification{}, fmt.Errorf("%w: %w", ErrInvalidNotification, err)
+		}
+
+		n = Notification{
+			ImpUID:         r.PostForm.Get("imp_uid"),
+			MerchantUID:    r.PostForm.Get("merchant_uid"),
+			Status:         r.PostForm.Get("status"),
+			CancellationID: r.PostForm.Get("cancellation_id"),
+		}
+	}
+
+	if n.ImpUID == "" {
+		return Notification{}, fmt.Errorf("%w: missing imp_uid", ErrInvalidNotification)
+	}
+
+	return n, nil
+}
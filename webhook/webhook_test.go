@@ -0,0 +1,202 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/connectfit-team/go-portone"
+	"github.com/connectfit-team/go-portone/webhook"
+)
+
+var _ webhook.PaymentGetter = (*portone.Client)(nil)
+
+type fakePayments map[string]portone.Payment
+
+func (f fakePayments) GetPayment(_ context.Context, impUID string) (portone.GetPaymentResponse, error) {
+	p, ok := f[impUID]
+	if !ok {
+		return portone.GetPaymentResponse{
+			CommonResponse: portone.CommonResponse{Code: -1, Message: "not found"},
+		}, nil
+	}
+
+	return portone.GetPaymentResponse{Response: p}, nil
+}
+
+func TestHandler(t *testing.T) {
+	payments := fakePayments{
+		"test_imp_uid": {
+			ImpUID:      "test_imp_uid",
+			MerchantUID: "test_merchant_uid",
+			Status:      "paid",
+			Amount:      1000,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		remoteAddr  string
+		header      http.Header
+		opts        []webhook.HandlerOption
+		fnErr       error
+		wantCode    int
+		wantCalled  bool
+	}{
+		{
+			name:        "json",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "test_imp_uid", "merchant_uid": "test_merchant_uid", "status": "paid"}`,
+			wantCode:    http.StatusOK,
+			wantCalled:  true,
+		},
+		{
+			name:        "form",
+			method:      http.MethodPost,
+			contentType: "application/x-www-form-urlencoded",
+			body:        url.Values{"imp_uid": {"test_imp_uid"}, "merchant_uid": {"test_merchant_uid"}, "status": {"paid"}}.Encode(),
+			wantCode:    http.StatusOK,
+			wantCalled:  true,
+		},
+		{
+			name:     "method not allowed",
+			method:   http.MethodGet,
+			wantCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:        "missing imp_uid",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"merchant_uid": "test_merchant_uid"}`,
+			wantCode:    http.StatusBadRequest,
+		},
+		{
+			name:        "mismatched merchant_uid",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "test_imp_uid", "merchant_uid": "forged_merchant_uid"}`,
+			wantCode:    http.StatusBadRequest,
+		},
+		{
+			name:        "unknown payment",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "forged_imp_uid"}`,
+			wantCode:    http.StatusBadGateway,
+		},
+		{
+			name:        "forbidden address",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "test_imp_uid"}`,
+			remoteAddr:  "10.0.0.1:1234",
+			opts:        []webhook.HandlerOption{webhook.WithAllowedIPs(webhook.DefaultAllowedIPs...)},
+			wantCode:    http.StatusForbidden,
+		},
+		{
+			name:        "allowed address",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "test_imp_uid"}`,
+			remoteAddr:  "52.78.100.19:1234",
+			opts:        []webhook.HandlerOption{webhook.WithAllowedIPs(webhook.DefaultAllowedIPs...)},
+			wantCode:    http.StatusOK,
+			wantCalled:  true,
+		},
+		{
+			name:        "forwarded allowed address",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "test_imp_uid"}`,
+			remoteAddr:  "10.0.0.1:1234",
+			header:      http.Header{"X-Forwarded-For": {"203.0.113.7, 52.78.100.19"}},
+			opts:        []webhook.HandlerOption{webhook.WithAllowedIPs(webhook.DefaultAllowedIPs...), webhook.WithForwardedFor()},
+			wantCode:    http.StatusOK,
+			wantCalled:  true,
+		},
+		{
+			name:        "spoofed forwarded address",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "test_imp_uid"}`,
+			remoteAddr:  "10.0.0.1:1234",
+			header:      http.Header{"X-Forwarded-For": {"52.78.100.19, 203.0.113.7"}},
+			opts:        []webhook.HandlerOption{webhook.WithAllowedIPs(webhook.DefaultAllowedIPs...), webhook.WithForwardedFor()},
+			wantCode:    http.StatusForbidden,
+		},
+		{
+			name:        "spoofed forwarded header line",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "test_imp_uid"}`,
+			remoteAddr:  "10.0.0.1:1234",
+			header:      http.Header{"X-Forwarded-For": {"52.78.100.19", "203.0.113.7"}},
+			opts:        []webhook.HandlerOption{webhook.WithAllowedIPs(webhook.DefaultAllowedIPs...), webhook.WithForwardedFor()},
+			wantCode:    http.StatusForbidden,
+		},
+		{
+			name:        "oversized json",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "test_imp_uid", "status": "` + strings.Repeat("a", 8<<10) + `"}`,
+			wantCode:    http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:        "oversized form",
+			method:      http.MethodPost,
+			contentType: "application/x-www-form-urlencoded",
+			body:        url.Values{"imp_uid": {"test_imp_uid"}, "status": {strings.Repeat("a", 8<<10)}}.Encode(),
+			wantCode:    http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:        "callback failure",
+			method:      http.MethodPost,
+			contentType: "application/json",
+			body:        `{"imp_uid": "test_imp_uid"}`,
+			fnErr:       errors.New("test error"),
+			wantCode:    http.StatusInternalServerError,
+			wantCalled:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			h := webhook.NewHandler(payments, func(ctx context.Context, payment portone.Payment) error {
+				called = true
+				if payment.Amount != 1000 {
+					t.Errorf("unexpected payment: %+v", payment)
+				}
+				return tt.fnErr
+			}, tt.opts...)
+
+			r := httptest.NewRequest(tt.method, "/webhook", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+			for key, values := range tt.header {
+				r.Header[key] = values
+			}
+			if tt.remoteAddr != "" {
+				r.RemoteAddr = tt.remoteAddr
+			}
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("unexpected status code: got %d, want %d", w.Code, tt.wantCode)
+			}
+
+			if called != tt.wantCalled {
+				t.Errorf("unexpected callback invocation: got %v, want %v", called, tt.wantCalled)
+			}
+		})
+	}
+}